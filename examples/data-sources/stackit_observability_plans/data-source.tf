@@ -0,0 +1,3 @@
+data "stackit_observability_plans" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+}
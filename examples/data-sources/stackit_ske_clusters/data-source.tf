@@ -0,0 +1,5 @@
+data "stackit_ske_clusters" "example" {
+  project_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name_regex = "^prod-"
+  statuses   = ["STATE_HEALTHY", "STATE_HIBERNATED"]
+}
@@ -0,0 +1,4 @@
+data "stackit_ske_kubernetes_versions" "example" {
+  version_state = "supported"
+  version_min   = "1.31"
+}
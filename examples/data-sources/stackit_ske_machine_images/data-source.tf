@@ -0,0 +1,4 @@
+data "stackit_ske_machine_images" "example" {
+  name          = "flatcar"
+  version_state = "supported"
+}
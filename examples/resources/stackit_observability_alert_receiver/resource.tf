@@ -0,0 +1,16 @@
+resource "stackit_observability_alert_receiver" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name        = "team-a"
+  email_configs = [
+    {
+      to = "team-a@example.com"
+    },
+  ]
+  webhooks_configs = [
+    {
+      url      = "https://example.webhook.office.com/xxxxxxxx"
+      ms_teams = true
+    },
+  ]
+}
@@ -0,0 +1,10 @@
+resource "stackit_observability_alert_route" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  receiver    = stackit_observability_alert_receiver.example.name
+  group_by    = ["alertname"]
+  match = {
+    team = "a"
+  }
+  repeat_interval = "4h"
+}
@@ -0,0 +1,24 @@
+resource "stackit_observability_alertgroups_from_yaml" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  rules_yaml  = file("${path.module}/rules.yaml")
+}
+
+# The rule file can also be written inline
+resource "stackit_observability_alertgroups_from_yaml" "inline" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  rules_yaml  = <<-EOT
+    groups:
+      - name: example-nodes
+        interval: 5m
+        rules:
+          - alert: NodeNotReady
+            expr: kube_node_status_condition{condition="Ready", status="false"} > 0
+            for: 5m
+            labels:
+              severity: critical
+            annotations:
+              summary: "Node {{ $labels.node }} is not ready"
+  EOT
+}
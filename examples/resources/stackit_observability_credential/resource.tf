@@ -1,4 +1,19 @@
 resource "stackit_observability_credential" "example" {
   project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
   instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  description = "Remote write from the staging cluster"
+}
+
+# Rotate the credential every 80 days. The new credential is created before the old one is deleted.
+resource "time_rotating" "rotate" {
+  rotation_days = 80
+}
+
+resource "stackit_observability_credential" "rotated" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+
+  rotate_when_changed = {
+    rotation = time_rotating.rotate.id
+  }
 }
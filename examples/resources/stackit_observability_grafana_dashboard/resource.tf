@@ -0,0 +1,26 @@
+resource "stackit_observability_grafana_dashboard" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  folder_uid  = stackit_observability_grafana_folder.example.uid
+  config_json = file("${path.module}/dashboard.json")
+}
+
+# Authenticate with an observability credential instead of the initial Grafana admin credentials
+resource "stackit_observability_grafana_dashboard" "with_credential" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  username    = stackit_observability_credential.example.username
+  password    = stackit_observability_credential.example.password
+  config_json = jsonencode({
+    uid   = "example-dashboard"
+    title = "Example dashboard"
+    panels = [
+      {
+        type    = "timeseries"
+        title   = "Up"
+        gridPos = { h = 8, w = 12, x = 0, y = 0 }
+        targets = [{ expr = "up" }]
+      }
+    ]
+  })
+}
@@ -0,0 +1,6 @@
+resource "stackit_observability_grafana_folder" "example" {
+  project_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  uid         = "example-folder"
+  title       = "Example folder"
+}
@@ -15,3 +15,41 @@ resource "stackit_observability_scrapeconfig" "example" {
     }
   ]
 }
+
+resource "stackit_observability_scrapeconfig" "example_oauth2" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  instance_id  = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name         = "example-oauth2-job"
+  metrics_path = "/metrics"
+  scheme       = "https"
+  oauth2 = {
+    client_id     = "my-client"
+    client_secret = "my-secret"
+    token_url     = "https://auth.example.com/token"
+    scopes        = ["metrics"]
+  }
+  tls_config = {
+    insecure_skip_verify = false
+  }
+  targets = [
+    {
+      urls = ["app.example.com"]
+    }
+  ]
+  http_sd_configs = [
+    {
+      url              = "https://discovery.example.com/targets"
+      refresh_interval = "5m"
+    }
+  ]
+  metrics_relabel_configs = [
+    {
+      action        = "drop"
+      source_labels = ["__name__"]
+      regex         = "go_.*"
+    }
+  ]
+  params = {
+    "module" = ["http_2xx"]
+  }
+}
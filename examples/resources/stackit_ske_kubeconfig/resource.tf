@@ -3,3 +3,18 @@ resource "stackit_ske_kubeconfig" "example" {
   cluster_name = "example-cluster"
   refresh      = true
 }
+
+# Replace the kubeconfig one day before it expires
+resource "stackit_ske_kubeconfig" "example_refresh_before" {
+  project_id     = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name   = "example-cluster"
+  expiration     = 604800
+  refresh_before = "24h"
+}
+
+# Kubeconfig obtaining short-lived credentials via the STACKIT CLI
+resource "stackit_ske_kubeconfig" "example_login" {
+  project_id        = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name      = "example-cluster"
+  kubeconfig_format = "login"
+}
@@ -0,0 +1,24 @@
+resource "stackit_ske_cluster" "example" {
+  project_id                 = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  name                       = "example"
+  ignore_external_node_pools = true
+  node_pools = [
+    {
+      name               = "np-system"
+      machine_type       = "x.x"
+      minimum            = "2"
+      maximum            = "3"
+      availability_zones = ["eu01-3"]
+    }
+  ]
+}
+
+resource "stackit_ske_node_pool" "example" {
+  project_id         = stackit_ske_cluster.example.project_id
+  cluster_name       = stackit_ske_cluster.example.name
+  name               = "np-example"
+  machine_type       = "x.x"
+  minimum            = "1"
+  maximum            = "3"
+  availability_zones = ["eu01-3"]
+}
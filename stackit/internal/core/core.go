@@ -53,6 +53,7 @@ type ProviderData struct {
 	ServiceEnablementCustomEndpoint string
 	ServiceAccountCustomEndpoint    string
 	EnableBetaResources             bool
+	BetaResources                   []string
 	Experiments                     []string
 
 	Version string // version of the STACKIT Terraform provider
@@ -116,7 +117,7 @@ func LogAndAddWarningBeta(ctx context.Context, diags *diag.Diagnostics, name str
 
 func LogAndAddErrorBeta(ctx context.Context, diags *diag.Diagnostics, name string, resourceType ResourceType) {
 	errTitle := fmt.Sprintf("The %s %q is in beta and beta is not enabled", resourceType, name)
-	errContent := fmt.Sprintf(`The %s %q is in beta and the beta functionality is currently not enabled. To enable it, set the environment variable STACKIT_TF_ENABLE_BETA_RESOURCES to "true" or set the "enable_beta_resources" provider field to true.`, resourceType, name)
+	errContent := fmt.Sprintf(`The %s %q is in beta and the beta functionality is currently not enabled. To enable only this %s, add %q to the "beta_resources" provider field or to the comma-separated environment variable STACKIT_TF_BETA_RESOURCES. To enable all beta resources, set the environment variable STACKIT_TF_ENABLE_BETA_RESOURCES to "true" or set the "enable_beta_resources" provider field to true.`, resourceType, name, resourceType, name)
 	tflog.Error(ctx, fmt.Sprintf("%s | %s", errTitle, errContent))
 	diags.AddError(errTitle, errContent)
 }
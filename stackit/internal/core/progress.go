@@ -0,0 +1,236 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type waitProgressKey struct{}
+
+// waitProgress keeps track of the status observed while waiting for an asynchronous operation
+type waitProgress struct {
+	mu         sync.Mutex
+	subject    string
+	start      time.Time
+	lastStatus string
+	lastErrors string
+}
+
+// InitWaitProgress returns a context which enables progress reporting for the API calls made with it.
+// It is meant to be passed to the SDK wait handlers: every poll logs the current status of the
+// resource together with the elapsed time, and the last observed status can later be added to the
+// diagnostics with WaitErrorDetail. The subject describes the awaited resource, e.g. "instance".
+func InitWaitProgress(ctx context.Context, subject string) context.Context {
+	return context.WithValue(ctx, waitProgressKey{}, &waitProgress{
+		subject: subject,
+		start:   time.Now(),
+	})
+}
+
+// WaitErrorDetail extends the detail of a failed wait with the last status and errors
+// observed through a context created with InitWaitProgress
+func WaitErrorDetail(ctx context.Context, detail string) string {
+	p, ok := ctx.Value(waitProgressKey{}).(*waitProgress)
+	if !ok {
+		return detail
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.lastStatus == "" {
+		return detail
+	}
+	detail = fmt.Sprintf("%s\nLast observed %s state: %s (after %s)", detail, p.subject, p.lastStatus, formatElapsed(time.Since(p.start)))
+	if p.lastErrors != "" {
+		detail = fmt.Sprintf("%s\nErrors reported by the API: %s", detail, p.lastErrors)
+	}
+	return detail
+}
+
+// WrapWaitError extends the message of a failed wait with the last status and errors observed through
+// a context created with InitWaitProgress. The original error can still be unwrapped.
+func WrapWaitError(ctx context.Context, err error) error {
+	if err == nil {
+		return nil
+	}
+	msg := WaitErrorDetail(ctx, err.Error())
+	if msg == err.Error() {
+		return err
+	}
+	return &waitError{err: err, msg: msg}
+}
+
+type waitError struct {
+	err error
+	msg string
+}
+
+func (e *waitError) Error() string { return e.msg }
+
+func (e *waitError) Unwrap() error { return e.err }
+
+func (p *waitProgress) observe(ctx context.Context, status, errs string) {
+	p.mu.Lock()
+	p.lastStatus = status
+	p.lastErrors = errs
+	elapsed := time.Since(p.start)
+	p.mu.Unlock()
+
+	msg := fmt.Sprintf("%s state: %s (%s elapsed)", p.subject, status, formatElapsed(elapsed))
+	if errs != "" {
+		msg = fmt.Sprintf("%s, errors: %s", msg, errs)
+	}
+	tflog.Info(ctx, msg)
+}
+
+func formatElapsed(d time.Duration) string {
+	if d < time.Minute {
+		return d.Round(time.Second).String()
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
+}
+
+// NewWaitProgressRoundTripper wraps the given round tripper so that the responses of requests made
+// with a context created by InitWaitProgress are used to report the progress of the wait
+func NewWaitProgressRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &waitProgressRoundTripper{next: rt}
+}
+
+type waitProgressRoundTripper struct {
+	next http.RoundTripper
+}
+
+func (rt *waitProgressRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || req.Method != http.MethodGet {
+		return resp, err
+	}
+	ctx := req.Context()
+	p, ok := ctx.Value(waitProgressKey{}).(*waitProgress)
+	if !ok {
+		return resp, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		p.observe(ctx, fmt.Sprintf("HTTP %d", resp.StatusCode), "")
+		return resp, nil
+	}
+	body, readErr := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if readErr != nil {
+		return resp, readErr
+	}
+	if status, errs := extractStatus(body); status != "" {
+		p.observe(ctx, status, errs)
+	}
+	return resp, nil
+}
+
+// extractStatus looks for the status of a resource and its errors in an API response body.
+// Depending on the API, the resource is either the response itself or wrapped in a single field
+// (e.g. "item", "zone" or "distribution").
+func extractStatus(body []byte) (status, errs string) {
+	var root map[string]any
+	if err := json.Unmarshal(body, &root); err != nil {
+		return "", ""
+	}
+	if status, errs = statusOf(root); status != "" {
+		return status, errs
+	}
+	for _, v := range root {
+		if obj, ok := v.(map[string]any); ok {
+			if status, errs = statusOf(obj); status != "" {
+				return status, errs
+			}
+		}
+	}
+	return "", ""
+}
+
+func statusOf(obj map[string]any) (status, errs string) {
+	errorSources := []any{obj["errors"], obj["error"], obj["errorMessage"]}
+	if s, ok := obj["status"].(string); ok {
+		status = s
+	} else if s, ok := obj["status"].(map[string]any); ok {
+		// SKE reports an aggregated state and the errors inside of the status
+		status = firstString(s, "aggregated", "state")
+		errorSources = append(errorSources, s["errors"], s["error"])
+	} else if s := firstString(obj, "state", "lifecycleState"); s != "" {
+		status = s
+	} else if op, ok := obj["lastOperation"].(map[string]any); ok {
+		// Data service APIs report the last operation, e.g. "create in progress"
+		parts := []string{}
+		for _, k := range []string{"type", "state"} {
+			if v := firstString(op, k); v != "" {
+				parts = append(parts, v)
+			}
+		}
+		status = strings.Join(parts, " ")
+		if op["state"] == "failed" {
+			errorSources = append(errorSources, op["description"])
+		}
+	}
+	if status == "" {
+		return "", ""
+	}
+
+	messages := []string{}
+	for _, source := range errorSources {
+		messages = append(messages, errorMessages(source)...)
+	}
+	return status, strings.Join(messages, "; ")
+}
+
+func errorMessages(v any) []string {
+	switch e := v.(type) {
+	case string:
+		if e == "" {
+			return nil
+		}
+		return []string{e}
+	case []any:
+		messages := []string{}
+		for _, item := range e {
+			messages = append(messages, errorMessages(item)...)
+		}
+		return messages
+	case map[string]any:
+		code := firstString(e, "code", "type", "key")
+		message := firstString(e, "message", "description", "en")
+		switch {
+		case code != "" && message != "":
+			return []string{fmt.Sprintf("%s: %s", code, message)}
+		case message != "":
+			return []string{message}
+		case code != "":
+			return []string{code}
+		}
+		if len(e) == 0 {
+			return nil
+		}
+		b, err := json.Marshal(e)
+		if err != nil {
+			return nil
+		}
+		return []string{string(b)}
+	}
+	return nil
+}
+
+func firstString(obj map[string]any, keys ...string) string {
+	for _, k := range keys {
+		if s, ok := obj[k].(string); ok && s != "" {
+			return s
+		}
+	}
+	return ""
+}
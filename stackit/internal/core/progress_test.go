@@ -0,0 +1,155 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestExtractStatus(t *testing.T) {
+	tests := []struct {
+		description    string
+		body           string
+		expectedStatus string
+		expectedErrors string
+	}{
+		{
+			"top_level_status",
+			`{"id":"iid","status":"CREATING"}`,
+			"CREATING",
+			"",
+		},
+		{
+			"wrapped_status",
+			`{"item":{"id":"iid","status":"Progressing"}}`,
+			"Progressing",
+			"",
+		},
+		{
+			"aggregated_status_with_errors",
+			`{"name":"cluster","status":{"aggregated":"STATE_UNHEALTHY","errors":[{"code":"SKE_QUOTA_EXCEEDED","message":"quota exceeded"}]}}`,
+			"STATE_UNHEALTHY",
+			"SKE_QUOTA_EXCEEDED: quota exceeded",
+		},
+		{
+			"status_with_errors",
+			`{"name":"lb","status":"STATUS_ERROR","errors":[{"type":"TYPE_FIP_NOT_CONFIGURED","description":"no floating IP"},{"description":"other"}]}`,
+			"STATUS_ERROR",
+			"TYPE_FIP_NOT_CONFIGURED: no floating IP; other",
+		},
+		{
+			"wrapped_state_with_error",
+			`{"rrset":{"id":"rid","state":"CREATE_FAILED","error":"invalid record"}}`,
+			"CREATE_FAILED",
+			"invalid record",
+		},
+		{
+			"wrapped_status_with_localized_errors",
+			`{"distribution":{"id":"did","status":"ERROR","errors":[{"key":"DNS","en":"record missing","de":"Eintrag fehlt"}]}}`,
+			"ERROR",
+			"DNS: record missing",
+		},
+		{
+			"lifecycle_state",
+			`{"containerId":"cid","lifecycleState":"CREATING"}`,
+			"CREATING",
+			"",
+		},
+		{
+			"last_operation",
+			`{"instanceId":"iid","lastOperation":{"type":"create","state":"in progress","description":"creating"}}`,
+			"create in progress",
+			"",
+		},
+		{
+			"last_operation_failed",
+			`{"instanceId":"iid","lastOperation":{"type":"create","state":"failed","description":"no capacity"}}`,
+			"create failed",
+			"no capacity",
+		},
+		{
+			"no_status",
+			`{"name":"bucket","urlPathStyle":"url"}`,
+			"",
+			"",
+		},
+		{
+			"no_json",
+			`not json`,
+			"",
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			status, errs := extractStatus([]byte(tt.body))
+			if status != tt.expectedStatus {
+				t.Errorf("expected status %q, got %q", tt.expectedStatus, status)
+			}
+			if errs != tt.expectedErrors {
+				t.Errorf("expected errors %q, got %q", tt.expectedErrors, errs)
+			}
+		})
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestWaitProgressRoundTripper(t *testing.T) {
+	body := `{"item":{"status":"FAILED","errors":["disk full"]}}`
+	rt := NewWaitProgressRoundTripper(roundTripperFunc(func(_ *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+
+	ctx := InitWaitProgress(context.Background(), "instance")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", http.NoBody)
+	if err != nil {
+		t.Fatalf("creating request: %v", err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("round trip: %v", err)
+	}
+	defer resp.Body.Close()
+	got, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	if string(got) != body {
+		t.Fatalf("body was not preserved, got %q", string(got))
+	}
+
+	detail := WaitErrorDetail(ctx, "Instance creation waiting: failed")
+	for _, expected := range []string{"Instance creation waiting: failed", "Last observed instance state: FAILED", "Errors reported by the API: disk full"} {
+		if !strings.Contains(detail, expected) {
+			t.Errorf("expected detail to contain %q, got %q", expected, detail)
+		}
+	}
+
+	waitErr := errors.New("wait failed")
+	wrapped := WrapWaitError(ctx, waitErr)
+	if !errors.Is(wrapped, waitErr) {
+		t.Errorf("wrapped error does not unwrap to the original error")
+	}
+	if !strings.Contains(wrapped.Error(), "Last observed instance state: FAILED") {
+		t.Errorf("wrapped error does not contain the last status, got %q", wrapped.Error())
+	}
+}
+
+func TestWaitErrorDetailWithoutProgress(t *testing.T) {
+	detail := WaitErrorDetail(context.Background(), "detail")
+	if detail != "detail" {
+		t.Errorf("expected unchanged detail, got %q", detail)
+	}
+	detail = WaitErrorDetail(InitWaitProgress(context.Background(), "instance"), "detail")
+	if detail != "detail" {
+		t.Errorf("expected unchanged detail without observed status, got %q", detail)
+	}
+}
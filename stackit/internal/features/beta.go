@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -35,12 +36,46 @@ Defaulting to the provider feature flag.`, value)
 	return data.EnableBetaResources
 }
 
-// CheckBetaResourcesEnabled is a helper function to log and add a warning or error if the beta functionality is not enabled.
+// BetaResourceEnabled returns whether the beta resource or data source with the given type name (e.g. `stackit_git`) is enabled.
+//
+// A beta resource is enabled if beta functionality is enabled globally (see BetaResourcesEnabled) or if its type name is listed in:
+//   - Environment Variable `STACKIT_TF_BETA_RESOURCES` - comma-separated list of type names.
+//   - Provider configuration field `beta_resources` - list of type names.
+func BetaResourceEnabled(ctx context.Context, data *core.ProviderData, diags *diag.Diagnostics, resourceName string) bool {
+	if BetaResourcesEnabled(ctx, data, diags) {
+		return true
+	}
+	return slices.ContainsFunc(enabledBetaResources(data), func(name string) bool {
+		return strings.EqualFold(name, resourceName)
+	})
+}
+
+// enabledBetaResources returns the type names of the individually enabled beta resources,
+// combining the environment variable `STACKIT_TF_BETA_RESOURCES` and the provider configuration.
+func enabledBetaResources(data *core.ProviderData) []string {
+	names := []string{}
+	if value, set := os.LookupEnv("STACKIT_TF_BETA_RESOURCES"); set {
+		for _, name := range strings.Split(value, ",") {
+			name = strings.TrimSpace(name)
+			if name != "" {
+				names = append(names, name)
+			}
+		}
+	}
+	// ProviderData should always be set, but we check just in case
+	if data != nil {
+		names = append(names, data.BetaResources...)
+	}
+	return names
+}
+
+// CheckBetaResourcesEnabled is a helper function to log and add a warning or error if the beta functionality is not enabled
+// for the given resource or data source.
 //
 // Should be called in the Configure method of a beta resource.
 // Then, check for Errors in the diags using the diags.HasError() method.
 func CheckBetaResourcesEnabled(ctx context.Context, data *core.ProviderData, diags *diag.Diagnostics, resourceName string, resourceType core.ResourceType) {
-	if !BetaResourcesEnabled(ctx, data, diags) {
+	if !BetaResourceEnabled(ctx, data, diags, resourceName) {
 		core.LogAndAddErrorBeta(ctx, diags, resourceName, resourceType)
 		return
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 )
 
@@ -214,3 +215,109 @@ func TestCheckBetaResourcesEnabled(t *testing.T) {
 		})
 	}
 }
+
+func TestBetaResourceEnabled(t *testing.T) {
+	tests := []struct {
+		description  string
+		data         *core.ProviderData
+		resourceName string
+		globalEnv    *string
+		listEnv      *string
+		expected     bool
+	}{
+		{
+			description:  "Nothing enabled",
+			data:         &core.ProviderData{},
+			resourceName: "stackit_test",
+			expected:     false,
+		},
+		{
+			description: "Global feature flag enabled",
+			data: &core.ProviderData{
+				EnableBetaResources: true,
+			},
+			resourceName: "stackit_test",
+			expected:     true,
+		},
+		{
+			description: "Resource in provider list",
+			data: &core.ProviderData{
+				BetaResources: []string{"stackit_other", "stackit_test"},
+			},
+			resourceName: "stackit_test",
+			expected:     true,
+		},
+		{
+			description: "Resource in provider list, case insensitive",
+			data: &core.ProviderData{
+				BetaResources: []string{"STACKIT_TEST"},
+			},
+			resourceName: "stackit_test",
+			expected:     true,
+		},
+		{
+			description: "Other resource in provider list",
+			data: &core.ProviderData{
+				BetaResources: []string{"stackit_other"},
+			},
+			resourceName: "stackit_test",
+			expected:     false,
+		},
+		{
+			description:  "Resource in env var list",
+			data:         &core.ProviderData{},
+			resourceName: "stackit_test",
+			listEnv:      utils.Ptr("stackit_other, stackit_test"),
+			expected:     true,
+		},
+		{
+			description:  "Other resource in env var list",
+			data:         &core.ProviderData{},
+			resourceName: "stackit_test",
+			listEnv:      utils.Ptr("stackit_other,,"),
+			expected:     false,
+		},
+		{
+			description: "Global env var is false, resource in provider list",
+			data: &core.ProviderData{
+				BetaResources: []string{"stackit_test"},
+			},
+			resourceName: "stackit_test",
+			globalEnv:    utils.Ptr("false"),
+			expected:     true,
+		},
+		{
+			description:  "Global env var is true, resource not in any list",
+			data:         &core.ProviderData{},
+			resourceName: "stackit_test",
+			globalEnv:    utils.Ptr("true"),
+			expected:     true,
+		},
+		{
+			description:  "Nil provider data, resource in env var list",
+			resourceName: "stackit_test",
+			listEnv:      utils.Ptr("stackit_test"),
+			expected:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			if tt.globalEnv != nil {
+				t.Setenv("STACKIT_TF_ENABLE_BETA_RESOURCES", *tt.globalEnv)
+			}
+			if tt.listEnv != nil {
+				t.Setenv("STACKIT_TF_BETA_RESOURCES", *tt.listEnv)
+			}
+			diags := diag.Diagnostics{}
+
+			result := BetaResourceEnabled(context.Background(), tt.data, &diags, tt.resourceName)
+			if result != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, result)
+			}
+			if diags.HasError() {
+				t.Fatalf("Expected no error, got %v", diags.Errors())
+			}
+		})
+	}
+}
@@ -154,9 +154,10 @@ func (r *customDomainResource) Create(ctx context.Context, req resource.CreateRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN custom domain", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.CreateCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name).SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "custom domain")
+	waitResp, err := wait.CreateCDNCustomDomainWaitHandler(waitCtx, r.client, projectId, distributionId, name).SetTimeout(5 * time.Minute).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN custom domain", fmt.Sprintf("Waiting for create: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN custom domain", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for create: %v", err)))
 		return
 	}
 
@@ -240,9 +241,10 @@ func (r *customDomainResource) Delete(ctx context.Context, req resource.DeleteRe
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN custom domain", fmt.Sprintf("Delete custom domain: %v", err))
 	}
-	_, err = wait.DeleteCDNCustomDomainWaitHandler(ctx, r.client, projectId, distributionId, name).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "custom domain")
+	_, err = wait.DeleteCDNCustomDomainWaitHandler(waitCtx, r.client, projectId, distributionId, name).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN custom domain", fmt.Sprintf("Waiting for deletion: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN custom domain", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for deletion: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "CDN custom domain deleted")
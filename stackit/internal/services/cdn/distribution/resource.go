@@ -295,9 +295,10 @@ func (r *distributionResource) Create(ctx context.Context, req resource.CreateRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.CreateDistributionPoolWaitHandler(ctx, r.client, projectId, *createResp.Distribution.Id).SetTimeout(5 * time.Minute).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "distribution")
+	waitResp, err := wait.CreateDistributionPoolWaitHandler(waitCtx, r.client, projectId, *createResp.Distribution.Id).SetTimeout(5 * time.Minute).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", fmt.Sprintf("Waiting for create: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating CDN distribution", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for create: %v", err)))
 		return
 	}
 
@@ -445,9 +446,10 @@ func (r *distributionResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	waitResp, err := wait.UpdateDistributionWaitHandler(ctx, r.client, projectId, distributionId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "distribution")
+	waitResp, err := wait.UpdateDistributionWaitHandler(waitCtx, r.client, projectId, distributionId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", fmt.Sprintf("Waiting for update: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Update CDN distribution", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for update: %v", err)))
 		return
 	}
 
@@ -481,9 +483,10 @@ func (r *distributionResource) Delete(ctx context.Context, req resource.DeleteRe
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN distribution", fmt.Sprintf("Delete distribution: %v", err))
 	}
-	_, err = wait.DeleteDistributionWaitHandler(ctx, r.client, projectId, distributionId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "distribution")
+	_, err = wait.DeleteDistributionWaitHandler(waitCtx, r.client, projectId, distributionId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN distribution", fmt.Sprintf("Waiting for deletion: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Delete CDN distribution", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for deletion: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "CDN distribution deleted")
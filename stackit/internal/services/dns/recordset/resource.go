@@ -221,9 +221,10 @@ func (r *recordSetResource) Create(ctx context.Context, req resource.CreateReque
 	}
 	ctx = tflog.SetField(ctx, "record_set_id", *recordSetResp.Rrset.Id)
 
-	waitResp, err := wait.CreateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "record set")
+	waitResp, err := wait.CreateRecordSetWaitHandler(waitCtx, r.client, projectId, zoneId, *recordSetResp.Rrset.Id).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating record set", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance creation waiting: %v", err)))
 		return
 	}
 
@@ -312,9 +313,10 @@ func (r *recordSetResource) Update(ctx context.Context, req resource.UpdateReque
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", err.Error())
 		return
 	}
-	waitResp, err := wait.PartialUpdateRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "record set")
+	waitResp, err := wait.PartialUpdateRecordSetWaitHandler(waitCtx, r.client, projectId, zoneId, recordSetId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating record set", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance update waiting: %v", err)))
 		return
 	}
 
@@ -353,9 +355,10 @@ func (r *recordSetResource) Delete(ctx context.Context, req resource.DeleteReque
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteRecordSetWaitHandler(ctx, r.client, projectId, zoneId, recordSetId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "record set")
+	_, err = wait.DeleteRecordSetWaitHandler(waitCtx, r.client, projectId, zoneId, recordSetId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting record set", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "DNS record set deleted")
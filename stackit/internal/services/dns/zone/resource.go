@@ -304,9 +304,10 @@ func (r *zoneResource) Create(ctx context.Context, req resource.CreateRequest, r
 	zoneId := *createResp.Zone.Id
 
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
-	waitResp, err := wait.CreateZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "zone")
+	waitResp, err := wait.CreateZoneWaitHandler(waitCtx, r.client, projectId, zoneId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", fmt.Sprintf("Zone creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating zone", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Zone creation waiting: %v", err)))
 		return
 	}
 
@@ -389,9 +390,10 @@ func (r *zoneResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.PartialUpdateZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "zone")
+	waitResp, err := wait.PartialUpdateZoneWaitHandler(waitCtx, r.client, projectId, zoneId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", fmt.Sprintf("Zone update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating zone", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Zone update waiting: %v", err)))
 		return
 	}
 
@@ -429,9 +431,10 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteZoneWaitHandler(ctx, r.client, projectId, zoneId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "zone")
+	_, err = wait.DeleteZoneWaitHandler(waitCtx, r.client, projectId, zoneId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", fmt.Sprintf("Zone deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting zone", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Zone deletion waiting: %v", err)))
 		return
 	}
 
@@ -439,20 +442,28 @@ func (r *zoneResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 // ImportState imports a resource into the Terraform state on success.
-// The expected format of the resource import identifier is: project_id,zone_id
+// The expected format of the resource import identifier is: project_id,zone_id or project_id,name=dns_name
 func (r *zoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing zone",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[zone_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[zone_id] or [project_id],name=[dns_name]  Got: %q", req.ID),
 		)
 		return
 	}
 
 	projectId := idParts[0]
 	zoneId := idParts[1]
+	if dnsName, ok := utils.ParseImportName(zoneId); ok {
+		var err error
+		zoneId, err = r.resolveZoneId(ctx, projectId, dnsName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing zone", fmt.Sprintf("Resolving zone by DNS name: %v", err))
+			return
+		}
+	}
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "zone_id", zoneId)
 
@@ -577,3 +588,19 @@ func toUpdatePayload(model *Model) (*dns.PartialUpdateZonePayload, error) {
 		Primaries:     nil, // API returns error if this field is set, even if nothing changes
 	}, nil
 }
+
+// resolveZoneId returns the ID of the zone with the given DNS name
+func (r *zoneResource) resolveZoneId(ctx context.Context, projectId, dnsName string) (string, error) {
+	listResp, err := r.client.ListZones(ctx, projectId).
+		DnsNameEq(dnsName).
+		StateNeq(string(dns.ZONESTATE_DELETE_SUCCEEDED)).
+		Execute()
+	if err != nil {
+		return "", fmt.Errorf("listing zones: %w", err)
+	}
+	ids := []string{}
+	for _, zone := range listResp.GetZones() {
+		ids = append(ids, zone.GetId())
+	}
+	return utils.ResolveImportName("zone", dnsName, ids)
+}
@@ -2,11 +2,19 @@ package dns
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/dns"
 )
@@ -435,3 +443,115 @@ func TestToPayloadUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestImportState(t *testing.T) {
+	tests := []struct {
+		description       string
+		importId          string
+		listResponse      *dns.ListZonesResponse
+		expectedId        string
+		expectedListCalls int
+		isValid           bool
+	}{
+		{
+			"id",
+			"pid,zid",
+			nil,
+			"zid",
+			0,
+			true,
+		},
+		{
+			"name",
+			"pid,name=example.com",
+			&dns.ListZonesResponse{Zones: &[]dns.Zone{{Id: utils.Ptr("zid"), DnsName: utils.Ptr("example.com")}}},
+			"zid",
+			1,
+			true,
+		},
+		{
+			"name_not_found",
+			"pid,name=example.com",
+			&dns.ListZonesResponse{Zones: &[]dns.Zone{}},
+			"",
+			1,
+			false,
+		},
+		{
+			"name_not_unique",
+			"pid,name=example.com",
+			&dns.ListZonesResponse{Zones: &[]dns.Zone{{Id: utils.Ptr("zid"), DnsName: utils.Ptr("example.com")}, {Id: utils.Ptr("zid-2"), DnsName: utils.Ptr("example.com")}}},
+			"",
+			1,
+			false,
+		},
+		{
+			"empty_name",
+			"pid,name=",
+			nil,
+			"name=",
+			0,
+			true,
+		},
+		{
+			"invalid_format",
+			"pid",
+			nil,
+			"",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			listCalls := 0
+			mockedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				listCalls++
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(tt.listResponse); err != nil {
+					t.Errorf("Failed to encode response: %v", err)
+				}
+			}))
+			defer mockedServer.Close()
+			client, err := dns.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &zoneResource{client: client}
+
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importId}, resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if listCalls != tt.expectedListCalls {
+				t.Fatalf("expected %d list calls, got %d", tt.expectedListCalls, listCalls)
+			}
+			if tt.isValid {
+				var projectId, id types.String
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("zone_id"), &id)...)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Failed to read state: %v", resp.Diagnostics.Errors())
+				}
+				if projectId.ValueString() != "pid" || id.ValueString() != tt.expectedId {
+					t.Fatalf("expected project_id %q and zone_id %q, got %q and %q", "pid", tt.expectedId, projectId.ValueString(), id.ValueString())
+				}
+			}
+		})
+	}
+}
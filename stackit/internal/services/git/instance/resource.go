@@ -217,9 +217,10 @@ func (g *gitResource) Create(ctx context.Context, req resource.CreateRequest, re
 	}
 
 	gitInstanceId := *gitInstanceResp.Id
-	_, err = wait.CreateGitInstanceWaitHandler(ctx, g.client, projectId, gitInstanceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	_, err = wait.CreateGitInstanceWaitHandler(waitCtx, g.client, projectId, gitInstanceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating git instance", fmt.Sprintf("Git instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating git instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Git instance creation waiting: %v", err)))
 		return
 	}
 
@@ -310,9 +311,10 @@ func (g *gitResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		return
 	}
 
-	_, err = wait.DeleteGitInstanceWaitHandler(ctx, g.client, projectId, instanceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	_, err = wait.DeleteGitInstanceWaitHandler(waitCtx, g.client, projectId, instanceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for instance deletion", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error waiting for instance deletion", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 
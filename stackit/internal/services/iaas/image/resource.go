@@ -424,11 +424,12 @@ func (r *imageResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Wait for image to become available
-	waiter := wait.UploadImageWaitHandler(ctx, r.client, projectId, *imageCreateResp.Id)
+	waitCtx := core.InitWaitProgress(ctx, "image")
+	waiter := wait.UploadImageWaitHandler(waitCtx, r.client, projectId, *imageCreateResp.Id)
 	waiter = waiter.SetTimeout(7 * 24 * time.Hour) // Set timeout to one week, to make the timeout useless
-	waitResp, err := waiter.WaitWithContext(ctx)
+	waitResp, err := waiter.WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", fmt.Sprintf("Waiting for image to become available: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating image", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for image to become available: %v", err)))
 		return
 	}
 
@@ -556,9 +557,10 @@ func (r *imageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteImageWaitHandler(ctx, r.client, projectId, imageId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "image")
+	_, err = wait.DeleteImageWaitHandler(waitCtx, r.client, projectId, imageId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image", fmt.Sprintf("image deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting image", core.WaitErrorDetail(waitCtx, fmt.Sprintf("image deletion waiting: %v", err)))
 		return
 	}
 
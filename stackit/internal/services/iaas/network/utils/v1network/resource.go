@@ -50,9 +50,10 @@ func Create(ctx context.Context, req resource.CreateRequest, resp *resource.Crea
 	}
 
 	networkId := *network.NetworkId
-	network, err = wait.CreateNetworkWaitHandler(ctx, client, projectId, networkId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "network")
+	network, err = wait.CreateNetworkWaitHandler(waitCtx, client, projectId, networkId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", fmt.Sprintf("Network creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Network creation waiting: %v", err)))
 		return
 	}
 
@@ -144,9 +145,10 @@ func Update(ctx context.Context, req resource.UpdateRequest, resp *resource.Upda
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.UpdateNetworkWaitHandler(ctx, client, projectId, networkId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "network")
+	waitResp, err := wait.UpdateNetworkWaitHandler(waitCtx, client, projectId, networkId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", fmt.Sprintf("Network update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Network update waiting: %v", err)))
 		return
 	}
 
@@ -183,9 +185,10 @@ func Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.Dele
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteNetworkWaitHandler(ctx, client, projectId, networkId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "network")
+	_, err = wait.DeleteNetworkWaitHandler(waitCtx, client, projectId, networkId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", fmt.Sprintf("Network deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Network deletion waiting: %v", err)))
 		return
 	}
 
@@ -248,9 +248,10 @@ func (r *networkAreaResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	networkArea, err := wait.CreateNetworkAreaWaitHandler(ctx, r.client, organizationId, *area.AreaId).WaitWithContext(context.Background())
+	waitCtx := core.InitWaitProgress(ctx, "network area")
+	networkArea, err := wait.CreateNetworkAreaWaitHandler(waitCtx, r.client, organizationId, *area.AreaId).WaitWithContext(context.Background())
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", fmt.Sprintf("Network area creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating network area", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Network area creation waiting: %v", err)))
 		return
 	}
 	networkAreaId := *networkArea.AreaId
@@ -357,9 +358,10 @@ func (r *networkAreaResource) Update(ctx context.Context, req resource.UpdateReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.UpdateNetworkAreaWaitHandler(ctx, r.client, organizationId, networkAreaId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "network area")
+	waitResp, err := wait.UpdateNetworkAreaWaitHandler(waitCtx, r.client, organizationId, networkAreaId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", fmt.Sprintf("Network area update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating network area", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Network area update waiting: %v", err)))
 		return
 	}
 
@@ -428,9 +430,10 @@ func (r *networkAreaResource) Delete(ctx context.Context, req resource.DeleteReq
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteNetworkAreaWaitHandler(ctx, r.client, organizationId, networkAreaId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "network area")
+	_, err = wait.DeleteNetworkAreaWaitHandler(waitCtx, r.client, organizationId, networkAreaId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area", fmt.Sprintf("Network area deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting network area", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Network area deletion waiting: %v", err)))
 		return
 	}
 
@@ -312,20 +312,28 @@ func (r *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 }
 
 // ImportState imports a resource into the Terraform state on success.
-// The expected format of the resource import identifier is: project_id,security_group_id
+// The expected format of the resource import identifier is: project_id,security_group_id or project_id,name=name
 func (r *securityGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing security group",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[security_group_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[security_group_id] or [project_id],name=[name]  Got: %q", req.ID),
 		)
 		return
 	}
 
 	projectId := idParts[0]
 	securityGroupId := idParts[1]
+	if name, ok := utils.ParseImportName(securityGroupId); ok {
+		var err error
+		securityGroupId, err = r.resolveSecurityGroupId(ctx, projectId, name)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing security group", fmt.Sprintf("Resolving security group by name: %v", err))
+			return
+		}
+	}
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "security_group_id", securityGroupId)
 
@@ -334,6 +342,21 @@ func (r *securityGroupResource) ImportState(ctx context.Context, req resource.Im
 	tflog.Info(ctx, "security group state imported")
 }
 
+// resolveSecurityGroupId returns the ID of the security group with the given name
+func (r *securityGroupResource) resolveSecurityGroupId(ctx context.Context, projectId, name string) (string, error) {
+	listResp, err := r.client.ListSecurityGroups(ctx, projectId).Execute()
+	if err != nil {
+		return "", fmt.Errorf("listing security groups: %w", err)
+	}
+	ids := []string{}
+	for _, securityGroup := range listResp.GetItems() {
+		if securityGroup.GetName() == name {
+			ids = append(ids, securityGroup.GetId())
+		}
+	}
+	return utils.ResolveImportName("security group", name, ids)
+}
+
 func mapFields(ctx context.Context, securityGroupResp *iaas.SecurityGroup, model *Model) error {
 	if securityGroupResp == nil {
 		return fmt.Errorf("response input is nil")
@@ -2,11 +2,20 @@ package securitygroup
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/iaas"
 )
@@ -216,3 +225,116 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestImportState(t *testing.T) {
+	projectId := uuid.NewString()
+	tests := []struct {
+		description       string
+		importId          string
+		listResponse      *iaas.SecurityGroupListResponse
+		expectedId        string
+		expectedListCalls int
+		isValid           bool
+	}{
+		{
+			"id",
+			projectId + ",sgid",
+			nil,
+			"sgid",
+			0,
+			true,
+		},
+		{
+			"name",
+			projectId + ",name=example",
+			&iaas.SecurityGroupListResponse{Items: &[]iaas.SecurityGroup{{Id: utils.Ptr("sgid"), Name: utils.Ptr("example")}, {Id: utils.Ptr("sgid-2"), Name: utils.Ptr("other")}}},
+			"sgid",
+			1,
+			true,
+		},
+		{
+			"name_not_found",
+			projectId + ",name=example",
+			&iaas.SecurityGroupListResponse{Items: &[]iaas.SecurityGroup{{Id: utils.Ptr("sgid-2"), Name: utils.Ptr("other")}}},
+			"",
+			1,
+			false,
+		},
+		{
+			"name_not_unique",
+			projectId + ",name=example",
+			&iaas.SecurityGroupListResponse{Items: &[]iaas.SecurityGroup{{Id: utils.Ptr("sgid"), Name: utils.Ptr("example")}, {Id: utils.Ptr("sgid-2"), Name: utils.Ptr("example")}}},
+			"",
+			1,
+			false,
+		},
+		{
+			"empty_name",
+			projectId + ",name=",
+			nil,
+			"name=",
+			0,
+			true,
+		},
+		{
+			"invalid_format",
+			"pid",
+			nil,
+			"",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			listCalls := 0
+			mockedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				listCalls++
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(tt.listResponse); err != nil {
+					t.Errorf("Failed to encode response: %v", err)
+				}
+			}))
+			defer mockedServer.Close()
+			client, err := iaas.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &securityGroupResource{client: client}
+
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importId}, resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if listCalls != tt.expectedListCalls {
+				t.Fatalf("expected %d list calls, got %d", tt.expectedListCalls, listCalls)
+			}
+			if tt.isValid {
+				var stateProjectId, id types.String
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_id"), &stateProjectId)...)
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("security_group_id"), &id)...)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Failed to read state: %v", resp.Diagnostics.Errors())
+				}
+				if stateProjectId.ValueString() != projectId || id.ValueString() != tt.expectedId {
+					t.Fatalf("expected project_id %q and security_group_id %q, got %q and %q", projectId, tt.expectedId, stateProjectId.ValueString(), id.ValueString())
+				}
+			}
+		})
+	}
+}
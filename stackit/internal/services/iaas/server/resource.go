@@ -42,6 +42,10 @@ var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithModifyPlan  = &serverResource{}
+
+	// machineTypesCache caches the available machine types per project
+	machineTypesCache utils.CatalogCache[[]string]
 
 	supportedSourceTypes = []string{"volume", "image"}
 	desiredStatusOptions = []string{modelStateActive, modelStateInactive, modelStateDeallocated}
@@ -145,6 +149,49 @@ func (r *serverResource) ConfigValidators(_ context.Context) []resource.ConfigVa
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the machine type against the machine types available in the project.
+func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration and deletion
+	if req.Config.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var configModel Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(configModel.ProjectId) || utils.IsUndefined(configModel.MachineType) {
+		return
+	}
+	// Only validate new or changed machine types, retired machine types of existing servers are kept
+	if !req.State.Raw.IsNull() {
+		var stateMachineType types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("machine_type"), &stateMachineType)...)
+		if resp.Diagnostics.HasError() || configModel.MachineType.Equal(stateMachineType) {
+			return
+		}
+	}
+
+	projectId := configModel.ProjectId.ValueString()
+	machineTypes, err := machineTypesCache.Get(projectId, func() ([]string, error) {
+		res, err := r.client.ListMachineTypes(ctx, projectId).Execute()
+		if err != nil {
+			return nil, err
+		}
+		names := []string{}
+		for _, machineType := range res.GetItems() {
+			names = append(names, machineType.GetName())
+		}
+		return names, nil
+	})
+	if err != nil {
+		utils.LogCatalogError(ctx, "machine types", err)
+		return
+	}
+	utils.ValidateCatalogValue(ctx, &resp.Diagnostics, "machine type", configModel.MachineType.ValueString(), machineTypes)
+}
+
 // Configure adds the provider configured client to the resource.
 func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
@@ -446,9 +493,10 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	serverId := *server.Id
-	_, err = wait.CreateServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "server")
+	_, err = wait.CreateServerWaitHandler(waitCtx, r.client, projectId, serverId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", fmt.Sprintf("server creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating server", core.WaitErrorDetail(waitCtx, fmt.Sprintf("server creation waiting: %v", err)))
 		return
 	}
 	ctx = tflog.SetField(ctx, "server_id", serverId)
@@ -496,9 +544,10 @@ func startServer(ctx context.Context, client serverControlClient, projectId, ser
 	if err := client.StartServerExecute(ctx, projectId, serverId); err != nil {
 		return fmt.Errorf("cannot start server: %w", err)
 	}
-	_, err := wait.StartServerWaitHandler(ctx, client, projectId, serverId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "server")
+	_, err := wait.StartServerWaitHandler(waitCtx, client, projectId, serverId).WaitWithContext(waitCtx)
 	if err != nil {
-		return fmt.Errorf("cannot check started server: %w", err)
+		return fmt.Errorf("cannot check started server: %w", core.WrapWaitError(waitCtx, err))
 	}
 	return nil
 }
@@ -508,9 +557,10 @@ func stopServer(ctx context.Context, client serverControlClient, projectId, serv
 	if err := client.StopServerExecute(ctx, projectId, serverId); err != nil {
 		return fmt.Errorf("cannot stop server: %w", err)
 	}
-	_, err := wait.StopServerWaitHandler(ctx, client, projectId, serverId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "server")
+	_, err := wait.StopServerWaitHandler(waitCtx, client, projectId, serverId).WaitWithContext(waitCtx)
 	if err != nil {
-		return fmt.Errorf("cannot check stopped server: %w", err)
+		return fmt.Errorf("cannot check stopped server: %w", core.WrapWaitError(waitCtx, err))
 	}
 	return nil
 }
@@ -520,9 +570,10 @@ func deallocatServer(ctx context.Context, client serverControlClient, projectId,
 	if err := client.DeallocateServerExecute(ctx, projectId, serverId); err != nil {
 		return fmt.Errorf("cannot deallocate server: %w", err)
 	}
-	_, err := wait.DeallocateServerWaitHandler(ctx, client, projectId, serverId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "server")
+	_, err := wait.DeallocateServerWaitHandler(waitCtx, client, projectId, serverId).WaitWithContext(waitCtx)
 	if err != nil {
-		return fmt.Errorf("cannot check deallocated server: %w", err)
+		return fmt.Errorf("cannot check deallocated server: %w", core.WrapWaitError(waitCtx, err))
 	}
 	return nil
 }
@@ -661,9 +712,10 @@ func (r *serverResource) updateServerAttributes(ctx context.Context, model, stat
 			return nil, fmt.Errorf("Resizing the server, calling API: %w", err)
 		}
 
-		_, err = wait.ResizeServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+		waitCtx := core.InitWaitProgress(ctx, "server")
+		_, err = wait.ResizeServerWaitHandler(waitCtx, r.client, projectId, serverId).WaitWithContext(waitCtx)
 		if err != nil {
-			return nil, fmt.Errorf("server resize waiting: %w", err)
+			return nil, fmt.Errorf("server resize waiting: %w", core.WrapWaitError(waitCtx, err))
 		}
 		// Update server model because the API doesn't return a server object as response
 		updatedServer.MachineType = modelMachineType
@@ -772,9 +824,10 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteServerWaitHandler(ctx, r.client, projectId, serverId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "server")
+	_, err = wait.DeleteServerWaitHandler(waitCtx, r.client, projectId, serverId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", fmt.Sprintf("server deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting server", core.WaitErrorDetail(waitCtx, fmt.Sprintf("server deletion waiting: %v", err)))
 		return
 	}
 
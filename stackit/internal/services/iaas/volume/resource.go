@@ -315,9 +315,10 @@ func (r *volumeResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	volumeId := *volume.Id
-	volume, err = wait.CreateVolumeWaitHandler(ctx, r.client, projectId, volumeId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "volume")
+	volume, err = wait.CreateVolumeWaitHandler(waitCtx, r.client, projectId, volumeId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume", fmt.Sprintf("volume creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating volume", core.WaitErrorDetail(waitCtx, fmt.Sprintf("volume creation waiting: %v", err)))
 		return
 	}
 
@@ -464,9 +465,10 @@ func (r *volumeResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteVolumeWaitHandler(ctx, r.client, projectId, volumeId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "volume")
+	_, err = wait.DeleteVolumeWaitHandler(waitCtx, r.client, projectId, volumeId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume", fmt.Sprintf("volume deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting volume", core.WaitErrorDetail(waitCtx, fmt.Sprintf("volume deletion waiting: %v", err)))
 		return
 	}
 
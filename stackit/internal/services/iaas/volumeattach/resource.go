@@ -150,9 +150,10 @@ func (r *volumeAttachResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	_, err = wait.AddVolumeToServerWaitHandler(ctx, r.client, projectId, serverId, volumeId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "volume attachment")
+	_, err = wait.AddVolumeToServerWaitHandler(waitCtx, r.client, projectId, serverId, volumeId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching volume to server", fmt.Sprintf("volume attachment waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error attaching volume to server", core.WaitErrorDetail(waitCtx, fmt.Sprintf("volume attachment waiting: %v", err)))
 		return
 	}
 
@@ -231,9 +232,10 @@ func (r *volumeAttachResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err = wait.RemoveVolumeFromServerWaitHandler(ctx, r.client, projectId, serverId, volumeId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "volume attachment")
+	_, err = wait.RemoveVolumeFromServerWaitHandler(waitCtx, r.client, projectId, serverId, volumeId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error removing volume from server", fmt.Sprintf("volume removal waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error removing volume from server", core.WaitErrorDetail(waitCtx, fmt.Sprintf("volume removal waiting: %v", err)))
 		return
 	}
 
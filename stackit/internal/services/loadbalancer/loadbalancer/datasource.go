@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
 	loadbalancerUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/loadbalancer/utils"
@@ -21,6 +22,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer"
+	"github.com/stackitcloud/stackit-sdk-go/services/loadbalancer/wait"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -105,6 +107,8 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 		"targets.display_name":                  "Target display name",
 		"ip":                                    "Target IP",
 		"region":                                "The resource region. If not defined, the provider region is used.",
+		"status":                                "The status of the Load Balancer, e.g. `STATUS_READY` or `STATUS_PENDING`.",
+		"wait_for_ready":                        "If set to `true`, reading the data source waits until the Load Balancer is ready. Can be used to wait for a Load Balancer created with `wait_for_ready = false`. Defaults to `false`.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -339,6 +343,14 @@ func (r *loadBalancerDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Optional:    true,
 				Description: descriptions["region"],
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: descriptions["wait_for_ready"],
+				Optional:    true,
+			},
 		},
 	}
 }
@@ -358,7 +370,15 @@ func (r *loadBalancerDataSource) Read(ctx context.Context, req datasource.ReadRe
 	ctx = tflog.SetField(ctx, "name", name)
 	ctx = tflog.SetField(ctx, "region", region)
 
-	lbResp, err := r.client.GetLoadBalancer(ctx, projectId, region, name).Execute()
+	var lbResp *loadbalancer.LoadBalancer
+	var err error
+	if model.WaitForReady.ValueBool() {
+		waitCtx := core.InitWaitProgress(ctx, "load balancer")
+		lbResp, err = wait.CreateLoadBalancerWaitHandler(waitCtx, r.client, projectId, region, name).SetTimeout(90 * time.Minute).WaitWithContext(waitCtx)
+		err = core.WrapWaitError(waitCtx, err)
+	} else {
+		lbResp, err = r.client.GetLoadBalancer(ctx, projectId, region, name).Execute()
+	}
 	if err != nil {
 		utils.LogError(
 			ctx,
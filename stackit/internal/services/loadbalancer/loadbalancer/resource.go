@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -59,6 +60,8 @@ type Model struct {
 	PrivateAddress  types.String `tfsdk:"private_address"`
 	TargetPools     types.List   `tfsdk:"target_pools"`
 	Region          types.String `tfsdk:"region"`
+	Status          types.String `tfsdk:"status"`
+	WaitForReady    types.Bool   `tfsdk:"wait_for_ready"`
 }
 
 // Struct corresponding to Model.Listeners[i]
@@ -328,6 +331,8 @@ func (r *loadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 		"server_name_indicators.name":           "A domain name to match in order to pass TLS traffic to the target pool in the current listener",
 		"private_address":                       "Transient private Load Balancer IP address. It can change any time.",
 		"target_pools":                          "List of all target pools which will be used in the Load Balancer. Limited to 20.",
+		"status":                                "The status of the Load Balancer, e.g. `STATUS_READY` or `STATUS_PENDING`.",
+		"wait_for_ready":                        "If set to `false`, the Load Balancer creation is not awaited: the resource is stored right after the creation request and `status` reports its readiness on later refreshes. Use the `stackit_loadbalancer` data source with `wait_for_ready = true` to wait for the Load Balancer in dependent resources. Defaults to `true`.",
 		"healthy_threshold":                     "Healthy threshold of the health checking.",
 		"interval":                              "Interval duration of health checking in seconds.",
 		"interval_jitter":                       "Interval duration threshold of the health checking in seconds.",
@@ -669,6 +674,16 @@ The example below creates the supporting infrastructure using the STACKIT Terraf
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: descriptions["wait_for_ready"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 		},
 	}
 }
@@ -701,10 +716,16 @@ func (r *loadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	waitResp, err := wait.CreateLoadBalancerWaitHandler(ctx, r.client, projectId, region, *createResp.Name).SetTimeout(90 * time.Minute).WaitWithContext(ctx)
-	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", fmt.Sprintf("Load balancer creation waiting: %v", err))
-		return
+	waitResp := createResp
+	if utils.IsUndefined(model.WaitForReady) || model.WaitForReady.ValueBool() {
+		waitCtx := core.InitWaitProgress(ctx, "load balancer")
+		waitResp, err = wait.CreateLoadBalancerWaitHandler(waitCtx, r.client, projectId, region, *createResp.Name).SetTimeout(90 * time.Minute).WaitWithContext(waitCtx)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating load balancer", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Load balancer creation waiting: %v", err)))
+			return
+		}
+	} else {
+		tflog.Info(ctx, "Not waiting for load balancer to be ready")
 	}
 
 	// Map response body to schema
@@ -757,6 +778,10 @@ func (r *loadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading load balancer", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	// wait_for_ready is not part of the API, it is unset after an import
+	if model.WaitForReady.IsNull() {
+		model.WaitForReady = types.BoolValue(true)
+	}
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, model)
@@ -856,9 +881,10 @@ func (r *loadBalancerResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	_, err = wait.DeleteLoadBalancerWaitHandler(ctx, r.client, projectId, region, name).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "load balancer")
+	_, err = wait.DeleteLoadBalancerWaitHandler(waitCtx, r.client, projectId, region, name).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting load balancer", fmt.Sprintf("Load balancer deleting waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting load balancer", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Load balancer deleting waiting: %v", err)))
 		return
 	}
 
@@ -1221,6 +1247,10 @@ func mapFields(ctx context.Context, lb *loadbalancer.LoadBalancer, m *Model, reg
 	m.PlanId = types.StringPointerValue(lb.PlanId)
 	m.ExternalAddress = types.StringPointerValue(lb.ExternalAddress)
 	m.PrivateAddress = types.StringPointerValue(lb.PrivateAddress)
+	m.Status = types.StringNull()
+	if lb.Status != nil {
+		m.Status = types.StringValue(string(*lb.Status))
+	}
 
 	err := mapListeners(lb, m)
 	if err != nil {
@@ -499,6 +499,7 @@ func TestMapFields(t *testing.T) {
 			"simple_values_ok",
 			&loadbalancer.LoadBalancer{
 				ExternalAddress: utils.Ptr("external_address"),
+				Status:          loadbalancer.LOADBALANCERSTATUS_READY.Ptr(),
 				Listeners: utils.Ptr([]loadbalancer.Listener{
 					{
 						DisplayName: utils.Ptr("display_name"),
@@ -633,6 +634,7 @@ func TestMapFields(t *testing.T) {
 					}),
 				}),
 				Region: types.StringValue(testRegion),
+				Status: types.StringValue(string(loadbalancer.LOADBALANCERSTATUS_READY)),
 			},
 			true,
 		},
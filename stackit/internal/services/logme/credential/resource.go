@@ -178,9 +178,10 @@ func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequ
 	credentialId := *credentialsResp.Id
 	ctx = tflog.SetField(ctx, "credential_id", credentialId)
 
-	waitResp, err := wait.CreateCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "credential")
+	waitResp, err := wait.CreateCredentialsWaitHandler(waitCtx, r.client, projectId, instanceId, credentialId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating credential", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance creation waiting: %v", err)))
 		return
 	}
 
@@ -267,9 +268,10 @@ func (r *credentialResource) Delete(ctx context.Context, req resource.DeleteRequ
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteCredentialsWaitHandler(ctx, r.client, projectId, instanceId, credentialId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "credential")
+	_, err = wait.DeleteCredentialsWaitHandler(waitCtx, r.client, projectId, instanceId, credentialId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting credential", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "LogMe credential deleted")
@@ -37,6 +37,10 @@ var (
 	_ resource.Resource                = &instanceResource{}
 	_ resource.ResourceWithConfigure   = &instanceResource{}
 	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.ResourceWithModifyPlan  = &instanceResource{}
+
+	// offeringsCache caches the LogMe offerings per project
+	offeringsCache utils.CatalogCache[[]utils.CatalogOffering]
 )
 
 type Model struct {
@@ -117,6 +121,58 @@ type instanceResource struct {
 	client *logme.APIClient
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to validate the version and plan name against the LogMe offerings.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip initial empty configuration and deletion
+	if req.Config.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var configModel Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(configModel.ProjectId) || utils.IsUndefined(configModel.Version) || utils.IsUndefined(configModel.PlanName) {
+		return
+	}
+	// Only validate new or changed values, retired versions and plans of existing instances are kept
+	var stateModel Model
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	versionChanged := !configModel.Version.Equal(stateModel.Version)
+	planNameChanged := !configModel.PlanName.Equal(stateModel.PlanName)
+	if !versionChanged && !planNameChanged {
+		return
+	}
+
+	projectId := configModel.ProjectId.ValueString()
+	offerings, err := offeringsCache.Get(projectId, func() ([]utils.CatalogOffering, error) {
+		res, err := r.client.ListOfferings(ctx, projectId).Execute()
+		if err != nil {
+			return nil, err
+		}
+		offerings := []utils.CatalogOffering{}
+		for _, offer := range res.GetOfferings() {
+			planNames := []string{}
+			for _, plan := range offer.GetPlans() {
+				planNames = append(planNames, plan.GetName())
+			}
+			offerings = append(offerings, utils.CatalogOffering{Version: offer.GetVersion(), PlanNames: planNames})
+		}
+		return offerings, nil
+	})
+	if err != nil {
+		utils.LogCatalogError(ctx, "LogMe offerings", err)
+		return
+	}
+	utils.ValidateOffering(ctx, &resp.Diagnostics, offerings, configModel.Version.ValueString(), configModel.PlanName.ValueString(), versionChanged, planNameChanged)
+}
+
 // Metadata returns the resource type name.
 func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_logme_instance"
@@ -422,9 +478,10 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	instanceId := *createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId).SetTimeout(90 * time.Minute).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.CreateInstanceWaitHandler(waitCtx, r.client, projectId, instanceId).SetTimeout(90 * time.Minute).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance creation waiting: %v", err)))
 		return
 	}
 
@@ -532,9 +589,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.PartialUpdateInstanceWaitHandler(waitCtx, r.client, projectId, instanceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance update waiting: %v", err)))
 		return
 	}
 
@@ -573,9 +631,10 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	_, err = wait.DeleteInstanceWaitHandler(waitCtx, r.client, projectId, instanceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "LogMe instance deleted")
@@ -2,12 +2,19 @@ package mariadb
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/mariadb"
 )
@@ -337,3 +344,128 @@ func TestToUpdatePayload(t *testing.T) {
 		})
 	}
 }
+
+func TestModifyPlan(t *testing.T) {
+	// version "1" and the plan "plan-retired" are no longer offered
+	offerings := mariadb.ListOfferingsResponse{
+		Offerings: &[]mariadb.Offering{
+			{
+				Version: utils.Ptr("2"),
+				Plans: &[]mariadb.Plan{
+					{Id: utils.Ptr("pid-1"), Name: utils.Ptr("plan-single")},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		description  string
+		version      string
+		planName     string
+		stateVersion string
+		statePlan    string
+		isValid      bool
+	}{
+		{
+			"create_valid",
+			"2",
+			"plan-single",
+			"",
+			"",
+			true,
+		},
+		{
+			"create_retired_version",
+			"1",
+			"plan-single",
+			"",
+			"",
+			false,
+		},
+		{
+			"unchanged_retired_version",
+			"1",
+			"plan-single",
+			"1",
+			"plan-single",
+			true,
+		},
+		{
+			"unchanged_retired_plan",
+			"2",
+			"plan-retired",
+			"2",
+			"plan-retired",
+			true,
+		},
+		{
+			"changed_to_retired_plan",
+			"2",
+			"plan-retired",
+			"2",
+			"plan-single",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			mockedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(offerings); err != nil {
+					t.Errorf("Failed to encode offerings: %v", err)
+				}
+			}))
+			defer mockedServer.Close()
+			client, err := mariadb.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &instanceResource{client: client}
+
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			// the offerings are cached per project, use a project per test case
+			toState := func(version, planName string) tfsdk.State {
+				state := tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				}
+				diags := state.Set(ctx, &Model{
+					ProjectId:  types.StringValue(tt.description),
+					Parameters: types.ObjectNull(parametersTypes),
+					Version:    types.StringValue(version),
+					PlanName:   types.StringValue(planName),
+				})
+				if diags.HasError() {
+					t.Fatalf("Failed to set state: %v", diags.Errors())
+				}
+				return state
+			}
+			configState := toState(tt.version, tt.planName)
+			state := tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}
+			if tt.stateVersion != "" {
+				state = toState(tt.stateVersion, tt.statePlan)
+			}
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: configState.Raw}
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: configState.Raw},
+				Plan:   plan,
+				State:  state,
+			}
+			resp := &resource.ModifyPlanResponse{Plan: plan}
+			r.ModifyPlan(ctx, req, resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -266,14 +266,15 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	_, err = serviceEnablementWait.EnableServiceWaitHandler(ctx, r.serviceEnablementClient, region, projectId, utils.ModelServingServiceId).
-		WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "service enablement")
+	_, err = serviceEnablementWait.EnableServiceWaitHandler(waitCtx, r.serviceEnablementClient, region, projectId, utils.ModelServingServiceId).
+		WaitWithContext(waitCtx)
 	if err != nil {
 		core.LogAndAddError(
 			ctx,
 			&resp.Diagnostics,
 			"Error enabling AI model serving",
-			fmt.Sprintf("Error enabling AI model serving: %v", err),
+			core.WaitErrorDetail(waitCtx, fmt.Sprintf("Error enabling AI model serving: %v", err)),
 		)
 		return
 	}
@@ -299,9 +300,10 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	waitResp, err := wait.CreateModelServingWaitHandler(ctx, r.client, region, projectId, *createTokenResp.Token.Id).WaitWithContext(ctx)
+	waitCtx = core.InitWaitProgress(ctx, "token")
+	waitResp, err := wait.CreateModelServingWaitHandler(waitCtx, r.client, region, projectId, *createTokenResp.Token.Id).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", fmt.Sprintf("Waiting for token to be active: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating AI model serving auth token", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for token to be active: %v", err)))
 		return
 	}
 
@@ -447,9 +449,10 @@ func (r *tokenResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	waitResp, err := wait.UpdateModelServingWaitHandler(ctx, r.client, region, projectId, tokenId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "token")
+	waitResp, err := wait.UpdateModelServingWaitHandler(waitCtx, r.client, region, projectId, tokenId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating AI model serving auth token", fmt.Sprintf("Waiting for token to be updated: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating AI model serving auth token", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for token to be updated: %v", err)))
 		return
 	}
 
@@ -504,10 +507,11 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err = wait.DeleteModelServingWaitHandler(ctx, r.client, region, projectId, tokenId).
-		WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "token")
+	_, err = wait.DeleteModelServingWaitHandler(waitCtx, r.client, region, projectId, tokenId).
+		WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting AI model serving auth token", fmt.Sprintf("Waiting for token to be deleted: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting AI model serving auth token", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for token to be deleted: %v", err)))
 		return
 	}
 
@@ -200,9 +200,10 @@ func (r *bucketResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	waitResp, err := wait.CreateBucketWaitHandler(ctx, r.client, projectId, region, bucketName).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "bucket")
+	waitResp, err := wait.CreateBucketWaitHandler(waitCtx, r.client, projectId, region, bucketName).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating bucket", fmt.Sprintf("Bucket creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating bucket", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Bucket creation waiting: %v", err)))
 		return
 	}
 
@@ -297,9 +298,10 @@ func (r *bucketResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		}
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting bucket", fmt.Sprintf("Calling API: %v", err))
 	}
-	_, err = wait.DeleteBucketWaitHandler(ctx, r.client, projectId, region, bucketName).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "bucket")
+	_, err = wait.DeleteBucketWaitHandler(waitCtx, r.client, projectId, region, bucketName).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting bucket", fmt.Sprintf("Bucket deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting bucket", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Bucket deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "ObjectStorage bucket deleted")
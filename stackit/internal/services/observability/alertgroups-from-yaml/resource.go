@@ -0,0 +1,580 @@
+package alertgroupsfromyaml
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &alertGroupsFromYAMLResource{}
+	_ resource.ResourceWithConfigure      = &alertGroupsFromYAMLResource{}
+	_ resource.ResourceWithValidateConfig = &alertGroupsFromYAMLResource{}
+	_ resource.ResourceWithModifyPlan     = &alertGroupsFromYAMLResource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"`
+	ProjectId  types.String `tfsdk:"project_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	RulesYAML  types.String `tfsdk:"rules_yaml"`
+	Groups     types.List   `tfsdk:"groups"`
+}
+
+type groupModel struct {
+	Name     types.String `tfsdk:"name"`
+	Interval types.String `tfsdk:"interval"`
+	Rules    types.List   `tfsdk:"rules"`
+}
+
+var groupTypes = map[string]attr.Type{
+	"name":     basetypes.StringType{},
+	"interval": basetypes.StringType{},
+	"rules":    basetypes.ListType{ElemType: types.ObjectType{AttrTypes: ruleTypes}},
+}
+
+type ruleModel struct {
+	Alert       types.String `tfsdk:"alert"`
+	Expression  types.String `tfsdk:"expression"`
+	For         types.String `tfsdk:"for"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+}
+
+var ruleTypes = map[string]attr.Type{
+	"alert":       basetypes.StringType{},
+	"expression":  basetypes.StringType{},
+	"for":         basetypes.StringType{},
+	"labels":      basetypes.MapType{ElemType: types.StringType},
+	"annotations": basetypes.MapType{ElemType: types.StringType},
+}
+
+// Descriptions for the resource schema are centralized here.
+var descriptions = map[string]string{
+	"main":        "Observability alert groups from YAML resource schema. Creates one alert group per group of a Prometheus rule file. Only alerting rules are supported. Must have a `region` specified in the provider configuration.",
+	"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`\".",
+	"project_id":  "STACKIT project ID to which the alert groups are associated.",
+	"instance_id": "Observability instance ID to which the alert groups are associated.",
+	"rules_yaml":  "The content of a Prometheus rule file, e.g. `file(\"rules.yaml\")`. Each entry of the top-level `groups` list is created as an alert group. The group names must be unique in the instance. Errors in the content are reported with the line and column in the YAML.",
+	"groups":      "The alert groups as they exist in the observability instance. Changes made outside of Terraform are detected per rule.",
+	"name":        "The name of the alert group.",
+	"interval":    "The frequency at which the rules of the group are evaluated.",
+	"alert":       "The name of the alert rule.",
+	"expression":  "The PromQL expression of the alert rule.",
+	"for":         "The duration for which the expression must be true before the alert fires.",
+	"labels":      "The labels added to the alerts.",
+	"annotations": "The annotations added to the alerts.",
+}
+
+// NewAlertGroupsFromYAMLResource is a helper function to simplify the provider implementation.
+func NewAlertGroupsFromYAMLResource() resource.Resource {
+	return &alertGroupsFromYAMLResource{}
+}
+
+// alertGroupsFromYAMLResource is the resource implementation.
+type alertGroupsFromYAMLResource struct {
+	client *observability.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *alertGroupsFromYAMLResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_alertgroups_from_yaml"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertGroupsFromYAMLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Observability alert groups from YAML client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *alertGroupsFromYAMLResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rules_yaml": schema.StringAttribute{
+				Description: descriptions["rules_yaml"],
+				Required:    true,
+			},
+			"groups": schema.ListNestedAttribute{
+				Description: descriptions["groups"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: descriptions["name"],
+							Computed:    true,
+						},
+						"interval": schema.StringAttribute{
+							Description: descriptions["interval"],
+							Computed:    true,
+						},
+						"rules": schema.ListNestedAttribute{
+							Description: "The alert rules of the group.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"alert": schema.StringAttribute{
+										Description: descriptions["alert"],
+										Computed:    true,
+									},
+									"expression": schema.StringAttribute{
+										Description: descriptions["expression"],
+										Computed:    true,
+									},
+									"for": schema.StringAttribute{
+										Description: descriptions["for"],
+										Computed:    true,
+									},
+									"labels": schema.MapAttribute{
+										Description: descriptions["labels"],
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+									"annotations": schema.MapAttribute{
+										Description: descriptions["annotations"],
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig reports the errors in the rule file with their location in the YAML.
+func (r *alertGroupsFromYAMLResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(model.RulesYAML) {
+		return
+	}
+
+	_, errs := parseRulesYAML(model.RulesYAML.ValueString())
+	for _, err := range errs {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error parsing rules_yaml", err.Error())
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// The planned groups are derived from the rule file, so that changes to the alert groups made outside of Terraform
+// show up as a difference to the groups read from the API.
+func (r *alertGroupsFromYAMLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip deletion
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(model.RulesYAML) {
+		return
+	}
+
+	groups, errs := parseRulesYAML(model.RulesYAML.ValueString())
+	if len(errs) > 0 {
+		// Already reported by ValidateConfig
+		return
+	}
+	groupModels, err := toGroupModels(ctx, groups)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning alert groups", fmt.Sprintf("Converting rule file: %v", err))
+		return
+	}
+	groupsTF, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: groupTypes}, groupModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("groups"), groupsTF)...)
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertGroupsFromYAMLResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	desired := []groupModel{}
+	resp.Diagnostics.Append(model.Groups.ElementsAs(ctx, &desired, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := r.applyGroups(ctx, projectId, instanceId, desired, nil)
+	// The state is also set on error, so that the alert groups created so far are deleted when the resource is replaced
+	model.Id = utils.BuildInternalTerraformId(projectId, instanceId)
+	resp.Diagnostics.Append(setGroups(ctx, &model, groups)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert groups", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Alert groups from YAML created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertGroupsFromYAMLResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	current := []groupModel{}
+	resp.Diagnostics.Append(model.Groups.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Alert groups deleted outside of Terraform are removed from the state and created again on the next apply
+	groups := []groupModel{}
+	for i := range current {
+		name := current[i].Name.ValueString()
+		alertGroupResp, err := r.client.GetAlertgroup(ctx, name, instanceId, projectId).Execute()
+		if err != nil {
+			var oapiErr *oapierror.GenericOpenAPIError
+			ok := errors.As(err, &oapiErr)
+			if ok && oapiErr.StatusCode == http.StatusNotFound {
+				continue
+			}
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading alert groups", fmt.Sprintf("Calling API for alert group %q: %v", name, err))
+			return
+		}
+		group, err := mapGroup(ctx, alertGroupResp.Data)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading alert groups", fmt.Sprintf("Processing API payload of alert group %q: %v", name, err))
+			return
+		}
+		groups = append(groups, *group)
+	}
+
+	// Set the updated state.
+	resp.Diagnostics.Append(setGroups(ctx, &model, groups)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+}
+
+// Update updates the alert groups which differ from the rule file, creates the missing ones and deletes the ones
+// which were removed from the rule file.
+func (r *alertGroupsFromYAMLResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	desired := []groupModel{}
+	resp.Diagnostics.Append(model.Groups.ElementsAs(ctx, &desired, false)...)
+	current := []groupModel{}
+	resp.Diagnostics.Append(stateModel.Groups.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	groups, err := r.applyGroups(ctx, projectId, instanceId, desired, current)
+	resp.Diagnostics.Append(setGroups(ctx, &model, groups)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert groups", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Alert groups from YAML updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertGroupsFromYAMLResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	current := []groupModel{}
+	resp.Diagnostics.Append(model.Groups.ElementsAs(ctx, &current, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.applyGroups(ctx, projectId, instanceId, nil, current)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting alert groups", err.Error())
+		return
+	}
+	tflog.Info(ctx, "Alert groups from YAML deleted")
+}
+
+// applyGroups creates, updates and deletes alert groups so that the alert groups of the instance match the desired ones.
+// Groups which are equal in both lists are not touched. It returns the groups which may exist in the instance afterwards:
+// the desired groups and, if an error occurred, the current groups which could not be deleted yet.
+func (r *alertGroupsFromYAMLResource) applyGroups(ctx context.Context, projectId, instanceId string, desired, current []groupModel) ([]groupModel, error) {
+	currentByName := map[string]*groupModel{}
+	for i := range current {
+		currentByName[current[i].Name.ValueString()] = &current[i]
+	}
+	desiredNames := map[string]bool{}
+	for i := range desired {
+		desiredNames[desired[i].Name.ValueString()] = true
+	}
+	remaining := []groupModel{}
+	for i := range current {
+		if !desiredNames[current[i].Name.ValueString()] {
+			remaining = append(remaining, current[i])
+		}
+	}
+
+	for i := range desired {
+		group := &desired[i]
+		name := group.Name.ValueString()
+		currentGroup, exists := currentByName[name]
+		if exists && currentGroup.equal(group) {
+			continue
+		}
+
+		rules, err := toRulesPayload(ctx, group)
+		if err != nil {
+			return append(desired, remaining...), fmt.Errorf("alert group %q: creating API payload: %w", name, err)
+		}
+		if exists {
+			payload := observability.UpdateAlertgroupPayload{
+				Interval: conversion.StringValueToPointer(group.Interval),
+				Rules:    &rules,
+			}
+			_, err = r.client.UpdateAlertgroup(ctx, name, instanceId, projectId).UpdateAlertgroupPayload(payload).Execute()
+		} else {
+			payload := observability.CreateAlertgroupsPayload{
+				Name:     conversion.StringValueToPointer(group.Name),
+				Interval: conversion.StringValueToPointer(group.Interval),
+				Rules:    &rules,
+			}
+			_, err = r.client.CreateAlertgroups(ctx, instanceId, projectId).CreateAlertgroupsPayload(payload).Execute()
+		}
+		if err != nil {
+			return append(desired, remaining...), fmt.Errorf("alert group %q: calling API: %w", name, err)
+		}
+		tflog.Info(ctx, fmt.Sprintf("Alert group %q applied", name))
+	}
+
+	for len(remaining) > 0 {
+		name := remaining[0].Name.ValueString()
+		_, err := r.client.DeleteAlertgroup(ctx, name, instanceId, projectId).Execute()
+		if err != nil {
+			var oapiErr *oapierror.GenericOpenAPIError
+			ok := errors.As(err, &oapiErr)
+			if !ok || oapiErr.StatusCode != http.StatusNotFound {
+				return append(desired, remaining...), fmt.Errorf("deleting alert group %q: calling API: %w", name, err)
+			}
+		}
+		remaining = remaining[1:]
+		tflog.Info(ctx, fmt.Sprintf("Alert group %q deleted", name))
+	}
+	return desired, nil
+}
+
+// equal reports whether both groups have the same interval and rules.
+func (g *groupModel) equal(other *groupModel) bool {
+	return g.Name.Equal(other.Name) && g.Interval.Equal(other.Interval) && g.Rules.Equal(other.Rules)
+}
+
+// setGroups sets the groups of the model, a nil slice is stored as an empty list.
+func setGroups(ctx context.Context, model *Model, groups []groupModel) diag.Diagnostics {
+	if groups == nil {
+		groups = []groupModel{}
+	}
+	groupsTF, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: groupTypes}, groups)
+	if !diags.HasError() {
+		model.Groups = groupsTF
+	}
+	return diags
+}
+
+// toRulesPayload generates the rules of the create and update payloads.
+func toRulesPayload(ctx context.Context, group *groupModel) ([]observability.UpdateAlertgroupsRequestInnerRulesInner, error) {
+	if group == nil {
+		return nil, fmt.Errorf("nil group")
+	}
+	rules := []ruleModel{}
+	diags := group.Rules.ElementsAs(ctx, &rules, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	payload := []observability.UpdateAlertgroupsRequestInnerRulesInner{}
+	for i := range rules {
+		rule := &rules[i]
+		p := observability.UpdateAlertgroupsRequestInnerRulesInner{
+			Alert: conversion.StringValueToPointer(rule.Alert),
+			Expr:  conversion.StringValueToPointer(rule.Expression),
+			For:   conversion.StringValueToPointer(rule.For),
+		}
+		if !utils.IsUndefined(rule.Labels) {
+			labels, err := conversion.ToStringInterfaceMap(ctx, rule.Labels)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: converting labels to Go map: %w", i+1, err)
+			}
+			p.Labels = &labels
+		}
+		if !utils.IsUndefined(rule.Annotations) {
+			annotations, err := conversion.ToStringInterfaceMap(ctx, rule.Annotations)
+			if err != nil {
+				return nil, fmt.Errorf("rule %d: converting annotations to Go map: %w", i+1, err)
+			}
+			p.Annotations = &annotations
+		}
+		payload = append(payload, p)
+	}
+	return payload, nil
+}
+
+// mapGroup maps an alert group of the API response to the representation stored in the `groups` attribute.
+func mapGroup(ctx context.Context, alertGroup *observability.AlertGroup) (*groupModel, error) {
+	if alertGroup == nil {
+		return nil, fmt.Errorf("nil alert group")
+	}
+	if alertGroup.Name == nil {
+		return nil, fmt.Errorf("found empty name")
+	}
+
+	interval := normalizeDuration(alertGroup.Interval)
+	if interval.IsNull() {
+		interval = types.StringValue(DefaultInterval.String())
+	}
+
+	rules := []ruleModel{}
+	if alertGroup.Rules != nil {
+		for _, r := range *alertGroup.Rules {
+			rm := ruleModel{
+				Alert:       types.StringPointerValue(r.Alert),
+				Expression:  types.StringPointerValue(r.Expr),
+				For:         normalizeDuration(r.For),
+				Labels:      types.MapNull(types.StringType),
+				Annotations: types.MapNull(types.StringType),
+			}
+			if r.Labels != nil && len(*r.Labels) > 0 {
+				labels, diags := types.MapValueFrom(ctx, types.StringType, *r.Labels)
+				if diags.HasError() {
+					return nil, core.DiagsToError(diags)
+				}
+				rm.Labels = labels
+			}
+			if r.Annotations != nil && len(*r.Annotations) > 0 {
+				annotations, diags := types.MapValueFrom(ctx, types.StringType, *r.Annotations)
+				if diags.HasError() {
+					return nil, core.DiagsToError(diags)
+				}
+				rm.Annotations = annotations
+			}
+			rules = append(rules, rm)
+		}
+	}
+
+	rulesTF, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleTypes}, rules)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	return &groupModel{
+		Name:     types.StringValue(*alertGroup.Name),
+		Interval: interval,
+		Rules:    rulesTF,
+	}, nil
+}
@@ -0,0 +1,291 @@
+package alertgroupsfromyaml
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/gorilla/mux"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func testGroup(name, interval string, rules ...map[string]attr.Value) groupModel {
+	ruleValues := []attr.Value{}
+	for _, r := range rules {
+		ruleValues = append(ruleValues, types.ObjectValueMust(ruleTypes, r))
+	}
+	return groupModel{
+		Name:     types.StringValue(name),
+		Interval: types.StringValue(interval),
+		Rules:    types.ListValueMust(types.ObjectType{AttrTypes: ruleTypes}, ruleValues),
+	}
+}
+
+func testRule(alert, expression string) map[string]attr.Value {
+	return map[string]attr.Value{
+		"alert":       types.StringValue(alert),
+		"expression":  types.StringValue(expression),
+		"for":         types.StringNull(),
+		"labels":      types.MapNull(types.StringType),
+		"annotations": types.MapNull(types.StringType),
+	}
+}
+
+func TestMapGroup(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observability.AlertGroup
+		expected    *groupModel
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&observability.AlertGroup{
+				Name:     utils.Ptr("node"),
+				Interval: utils.Ptr("60s"),
+				Rules: &[]observability.AlertRuleRecord{
+					{
+						Alert: utils.Ptr("InstanceDown"),
+						Expr:  utils.Ptr("up == 0"),
+						For:   utils.Ptr("0s"),
+					},
+				},
+			},
+			utils.Ptr(testGroup("node", "1m", testRule("InstanceDown", "up == 0"))),
+			true,
+		},
+		{
+			"values_ok",
+			&observability.AlertGroup{
+				Name:     utils.Ptr("node"),
+				Interval: utils.Ptr("5m"),
+				Rules: &[]observability.AlertRuleRecord{
+					{
+						Alert:       utils.Ptr("HighLoad"),
+						Expr:        utils.Ptr("node_load1 > 4"),
+						For:         utils.Ptr("90s"),
+						Labels:      &map[string]string{"severity": "warning"},
+						Annotations: &map[string]string{},
+					},
+				},
+			},
+			utils.Ptr(testGroup("node", "5m", map[string]attr.Value{
+				"alert":      types.StringValue("HighLoad"),
+				"expression": types.StringValue("node_load1 > 4"),
+				"for":        types.StringValue("1m30s"),
+				"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"severity": types.StringValue("warning"),
+				}),
+				"annotations": types.MapNull(types.StringType),
+			})),
+			true,
+		},
+		{
+			"no_interval_no_rules",
+			&observability.AlertGroup{
+				Name: utils.Ptr("node"),
+			},
+			utils.Ptr(testGroup("node", "1m")),
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			nil,
+			false,
+		},
+		{
+			"no_name",
+			&observability.AlertGroup{},
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapGroup(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToRulesPayload(t *testing.T) {
+	group := testGroup("node", "1m",
+		map[string]attr.Value{
+			"alert":      types.StringValue("HighLoad"),
+			"expression": types.StringValue("node_load1 > 4"),
+			"for":        types.StringValue("1m30s"),
+			"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"severity": types.StringValue("warning"),
+			}),
+			"annotations": types.MapNull(types.StringType),
+		},
+		testRule("InstanceDown", "up == 0"),
+	)
+	expected := []observability.UpdateAlertgroupsRequestInnerRulesInner{
+		{
+			Alert:  utils.Ptr("HighLoad"),
+			Expr:   utils.Ptr("node_load1 > 4"),
+			For:    utils.Ptr("1m30s"),
+			Labels: &map[string]interface{}{"severity": "warning"},
+		},
+		{
+			Alert: utils.Ptr("InstanceDown"),
+			Expr:  utils.Ptr("up == 0"),
+		},
+	}
+
+	output, err := toRulesPayload(context.Background(), &group)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	_, err = toRulesPayload(context.Background(), nil)
+	if err == nil {
+		t.Fatalf("Should have failed for nil group")
+	}
+}
+
+func TestApplyGroups(t *testing.T) {
+	unchanged := testGroup("unchanged", "1m", testRule("A", "up == 0"))
+	drifted := testGroup("changed", "1m", testRule("B", "up == 1"))
+	changed := testGroup("changed", "1m", testRule("B", "up == 0"))
+	removed := testGroup("removed", "1m", testRule("C", "up == 0"))
+	added := testGroup("added", "5m", testRule("D", "up == 0"))
+
+	tests := []struct {
+		description    string
+		desired        []groupModel
+		current        []groupModel
+		failingRequest string
+		expectedCalls  []string
+		expectedGroups []groupModel
+		isValid        bool
+	}{
+		{
+			"create",
+			[]groupModel{unchanged, added},
+			nil,
+			"",
+			[]string{"POST", "POST"},
+			[]groupModel{unchanged, added},
+			true,
+		},
+		{
+			"update_drift",
+			[]groupModel{unchanged, changed, added},
+			[]groupModel{unchanged, drifted, removed},
+			"",
+			[]string{"PUT changed", "POST", "DELETE removed"},
+			[]groupModel{unchanged, changed, added},
+			true,
+		},
+		{
+			"no_changes",
+			[]groupModel{unchanged},
+			[]groupModel{unchanged},
+			"",
+			[]string{},
+			[]groupModel{unchanged},
+			true,
+		},
+		{
+			"delete",
+			nil,
+			[]groupModel{unchanged, removed},
+			"",
+			[]string{"DELETE unchanged", "DELETE removed"},
+			nil,
+			true,
+		},
+		{
+			"delete_fails",
+			[]groupModel{changed},
+			[]groupModel{drifted, removed},
+			"DELETE removed",
+			[]string{"PUT changed", "DELETE removed"},
+			[]groupModel{changed, removed},
+			false,
+		},
+		{
+			"create_fails",
+			[]groupModel{added},
+			[]groupModel{removed},
+			"POST",
+			[]string{"POST"},
+			[]groupModel{added, removed},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			calls := []string{}
+			handler := func(w http.ResponseWriter, r *http.Request) {
+				call := r.Method
+				if name, ok := mux.Vars(r)["groupName"]; ok {
+					call = fmt.Sprintf("%s %s", r.Method, name)
+				}
+				calls = append(calls, call)
+				w.Header().Set("Content-Type", "application/json")
+				if call == tt.failingRequest {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+				_, err := w.Write([]byte(`{"message": "ok"}`))
+				if err != nil {
+					t.Errorf("Failed to write response: %v", err)
+				}
+			}
+			router := mux.NewRouter()
+			router.HandleFunc("/v1/projects/{projectId}/instances/{instanceId}/alertgroups", handler)
+			router.HandleFunc("/v1/projects/{projectId}/instances/{instanceId}/alertgroups/{groupName}", handler)
+			mockedServer := httptest.NewServer(router)
+			defer mockedServer.Close()
+			client, err := observability.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &alertGroupsFromYAMLResource{client: client}
+
+			groups, err := r.applyGroups(context.Background(), "pid", "iid", tt.desired, tt.current)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			diff := cmp.Diff(calls, tt.expectedCalls)
+			if diff != "" {
+				t.Fatalf("API calls do not match: %s", diff)
+			}
+			diff = cmp.Diff(groups, tt.expectedGroups)
+			if diff != "" {
+				t.Fatalf("Groups do not match: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package alertgroupsfromyaml
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/model/rulefmt"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+	"gopkg.in/yaml.v3"
+)
+
+// Limits of the alert groups API, the same as enforced by the stackit_observability_alertgroup resource.
+const (
+	maxNameLength       = 200
+	maxExpressionLength = 600
+	maxLabels           = 10
+	maxAnnotations      = 5
+	maxLabelLength      = 200
+	minInterval         = model.Duration(60 * time.Second)
+)
+
+// DefaultInterval is the evaluation interval the API uses for groups without an interval.
+const DefaultInterval = minInterval
+
+var (
+	nameRegex      = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+	labelNameRegex = regexp.MustCompile(validate.PrometheusLabelRegex)
+)
+
+// ruleGroupsNode mirrors the structure of a rule file with YAML nodes, to report errors with their line and column.
+type ruleGroupsNode struct {
+	Groups []ruleGroupNode `yaml:"groups"`
+}
+
+type ruleGroupNode struct {
+	Name        yaml.Node  `yaml:"name"`
+	Interval    yaml.Node  `yaml:"interval"`
+	QueryOffset yaml.Node  `yaml:"query_offset"`
+	Limit       yaml.Node  `yaml:"limit"`
+	Labels      yaml.Node  `yaml:"labels"`
+	Rules       []ruleNode `yaml:"rules"`
+}
+
+type ruleNode struct {
+	Record        yaml.Node `yaml:"record"`
+	Alert         yaml.Node `yaml:"alert"`
+	Expr          yaml.Node `yaml:"expr"`
+	KeepFiringFor yaml.Node `yaml:"keep_firing_for"`
+	Labels        yaml.Node `yaml:"labels"`
+	Annotations   yaml.Node `yaml:"annotations"`
+}
+
+// parseRulesYAML parses the content of a Prometheus rule file. Besides the checks done by Prometheus, it checks
+// that the groups can be created by the alert groups API. The errors are prefixed with the line and column of the
+// offending YAML node.
+func parseRulesYAML(content string) ([]rulefmt.RuleGroup, []error) {
+	groups, errs := rulefmt.Parse([]byte(content), false)
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	var nodes ruleGroupsNode
+	if err := yaml.Unmarshal([]byte(content), &nodes); err != nil {
+		return nil, []error{err}
+	}
+	if len(groups.Groups) == 0 {
+		return nil, []error{fmt.Errorf("no rule groups found, expected a rule file with a top-level 'groups' list")}
+	}
+
+	for i := range groups.Groups {
+		errs = append(errs, checkRuleGroup(&groups.Groups[i], &nodes.Groups[i])...)
+	}
+	if len(errs) > 0 {
+		return nil, errs
+	}
+	return groups.Groups, nil
+}
+
+// checkRuleGroup checks that the rule group only uses features supported by the alert groups API.
+func checkRuleGroup(group *rulefmt.RuleGroup, node *ruleGroupNode) []error {
+	errs := []error{}
+	groupError := func(n *yaml.Node, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%d:%d: group %q: %s", n.Line, n.Column, group.Name, fmt.Sprintf(format, args...)))
+	}
+
+	if len(group.Name) > maxNameLength {
+		groupError(&node.Name, "name must be at most %d characters long", maxNameLength)
+	}
+	if !nameRegex.MatchString(group.Name) {
+		groupError(&node.Name, "name must match the regex %q", nameRegex.String())
+	}
+	if group.Interval != 0 && group.Interval < minInterval {
+		groupError(&node.Interval, "interval must be at least %s", minInterval)
+	}
+	unsupportedFields := []struct {
+		name string
+		node *yaml.Node
+	}{
+		{"query_offset", &node.QueryOffset},
+		{"limit", &node.Limit},
+		{"labels", &node.Labels},
+	}
+	for _, field := range unsupportedFields {
+		if field.node.Kind != 0 {
+			groupError(field.node, "field '%s' is not supported by the alert groups API", field.name)
+		}
+	}
+	if len(group.Rules) == 0 {
+		groupError(&node.Name, "group must contain at least one rule")
+	}
+
+	for i := range group.Rules {
+		r := &group.Rules[i]
+		n := &node.Rules[i]
+		ruleError := func(field *yaml.Node, format string, args ...any) {
+			errs = append(errs, fmt.Errorf("%d:%d: group %q, rule %d, %q: %s", field.Line, field.Column, group.Name, i+1, r.Alert+r.Record, fmt.Sprintf(format, args...)))
+		}
+
+		if r.Record != "" {
+			ruleError(&n.Record, "recording rules are not supported, only alerting rules")
+			continue
+		}
+		if len(r.Alert) > maxNameLength {
+			ruleError(&n.Alert, "alert name must be at most %d characters long", maxNameLength)
+		}
+		if !nameRegex.MatchString(r.Alert) {
+			ruleError(&n.Alert, "alert name must match the regex %q", nameRegex.String())
+		}
+		if len(strings.TrimSpace(r.Expr)) > maxExpressionLength {
+			ruleError(&n.Expr, "expression must be at most %d characters long", maxExpressionLength)
+		}
+		if r.KeepFiringFor != 0 {
+			ruleError(&n.KeepFiringFor, "field 'keep_firing_for' is not supported by the alert groups API")
+		}
+		if len(r.Labels) > maxLabels {
+			ruleError(&n.Labels, "at most %d labels are supported", maxLabels)
+		}
+		if len(r.Annotations) > maxAnnotations {
+			ruleError(&n.Annotations, "at most %d annotations are supported", maxAnnotations)
+		}
+		maps := []struct {
+			kind   string
+			node   *yaml.Node
+			values map[string]string
+		}{
+			{"label", &n.Labels, r.Labels},
+			{"annotation", &n.Annotations, r.Annotations},
+		}
+		for _, m := range maps {
+			for k, v := range m.values {
+				if len(k) > maxLabelLength || !labelNameRegex.MatchString(k) {
+					ruleError(m.node, "%s name %q must match the regex %q and be at most %d characters long", m.kind, k, validate.PrometheusLabelRegex, maxLabelLength)
+				}
+				if len(v) > maxLabelLength {
+					ruleError(m.node, "value of %s %q must be at most %d characters long", m.kind, k, maxLabelLength)
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// toGroupModels converts the parsed rule groups to the representation stored in the `groups` attribute.
+func toGroupModels(ctx context.Context, groups []rulefmt.RuleGroup) ([]groupModel, error) {
+	groupModels := []groupModel{}
+	for i := range groups {
+		group := &groups[i]
+		interval := group.Interval
+		if interval == 0 {
+			interval = DefaultInterval
+		}
+
+		rules := []ruleModel{}
+		for j := range group.Rules {
+			r := &group.Rules[j]
+			rm := ruleModel{
+				Alert: types.StringValue(r.Alert),
+				// Block scalars add a trailing newline to the expression, which the API does not return
+				Expression:  types.StringValue(strings.TrimSpace(r.Expr)),
+				For:         types.StringNull(),
+				Labels:      types.MapNull(types.StringType),
+				Annotations: types.MapNull(types.StringType),
+			}
+			if r.For != 0 {
+				rm.For = types.StringValue(r.For.String())
+			}
+			if len(r.Labels) > 0 {
+				labels, diags := types.MapValueFrom(ctx, types.StringType, r.Labels)
+				if diags.HasError() {
+					return nil, fmt.Errorf("group %q, rule %d: mapping labels", group.Name, j+1)
+				}
+				rm.Labels = labels
+			}
+			if len(r.Annotations) > 0 {
+				annotations, diags := types.MapValueFrom(ctx, types.StringType, r.Annotations)
+				if diags.HasError() {
+					return nil, fmt.Errorf("group %q, rule %d: mapping annotations", group.Name, j+1)
+				}
+				rm.Annotations = annotations
+			}
+			rules = append(rules, rm)
+		}
+
+		rulesTF, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ruleTypes}, rules)
+		if diags.HasError() {
+			return nil, fmt.Errorf("group %q: mapping rules", group.Name)
+		}
+		groupModels = append(groupModels, groupModel{
+			Name:     types.StringValue(group.Name),
+			Interval: types.StringValue(interval.String()),
+			Rules:    rulesTF,
+		})
+	}
+	return groupModels, nil
+}
+
+// normalizeDuration formats a duration returned by the API the same way as the durations parsed from the rule file.
+// Zero or empty durations are mapped to null.
+func normalizeDuration(duration *string) types.String {
+	if duration == nil || *duration == "" {
+		return types.StringNull()
+	}
+	d, err := model.ParseDuration(*duration)
+	if err != nil {
+		return types.StringValue(*duration)
+	}
+	if d == 0 {
+		return types.StringNull()
+	}
+	return types.StringValue(d.String())
+}
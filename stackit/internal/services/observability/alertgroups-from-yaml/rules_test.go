@@ -0,0 +1,233 @@
+package alertgroupsfromyaml
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+)
+
+const validRulesYAML = `groups:
+  - name: node
+    interval: 5m
+    rules:
+      - alert: HighLoad
+        expr: |
+          node_load1 > 4
+        for: 90s
+        labels:
+          severity: warning
+        annotations:
+          summary: "Load of {{ $labels.instance }} is high"
+      - alert: InstanceDown
+        expr: up == 0
+  - name: blackbox
+    rules:
+      - alert: ProbeFailed
+        expr: probe_success == 0
+`
+
+func TestParseRulesYAML(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+		// expectedErrors are substrings expected in the errors, e.g. the location
+		expectedErrors []string
+	}{
+		{
+			"valid",
+			validRulesYAML,
+			true,
+			nil,
+		},
+		{
+			"empty",
+			"",
+			false,
+			[]string{"no rule groups found"},
+		},
+		{
+			"invalid_yaml",
+			"groups:\n  - name: [node\n",
+			false,
+			[]string{"line 1"},
+		},
+		{
+			"unknown_field",
+			"groups:\n  - name: node\n    rules:\n      - alert: A\n        expression: up == 0\n",
+			false,
+			[]string{"line 5", "expression"},
+		},
+		{
+			"invalid_expression",
+			"groups:\n  - name: node\n    rules:\n      - alert: A\n        expr: sum(up\n",
+			false,
+			[]string{"5:15:", "could not parse expression"},
+		},
+		{
+			"recording_rule",
+			"groups:\n  - name: node\n    rules:\n      - record: job:up:sum\n        expr: sum by (job) (up)\n",
+			false,
+			[]string{"4:17:", "recording rules are not supported"},
+		},
+		{
+			"invalid_group_name",
+			"groups:\n  - name: node_exporter\n    rules:\n      - alert: A\n        expr: up == 0\n",
+			false,
+			[]string{"2:11:", `group "node_exporter"`},
+		},
+		{
+			"invalid_alert_name",
+			"groups:\n  - name: node\n    rules:\n      - alert: Instance_Down\n        expr: up == 0\n",
+			false,
+			[]string{"4:16:", `rule 1, "Instance_Down"`},
+		},
+		{
+			"interval_too_short",
+			"groups:\n  - name: node\n    interval: 30s\n    rules:\n      - alert: A\n        expr: up == 0\n",
+			false,
+			[]string{"3:15:", "interval must be at least 1m"},
+		},
+		{
+			"unsupported_fields",
+			"groups:\n  - name: node\n    limit: 10\n    rules:\n      - alert: A\n        expr: up == 0\n        keep_firing_for: 5m\n",
+			false,
+			[]string{"3:12:", "'limit'", "7:26:", "'keep_firing_for'"},
+		},
+		{
+			"no_rules",
+			"groups:\n  - name: node\n    rules: []\n",
+			false,
+			[]string{"at least one rule"},
+		},
+		{
+			"invalid_annotation_name",
+			"groups:\n  - name: node\n    rules:\n      - alert: A\n        expr: up == 0\n        annotations:\n          run-book: x\n",
+			false,
+			[]string{"7:11:", `annotation name "run-book"`},
+		},
+		{
+			"too_many_annotations",
+			"groups:\n  - name: node\n    rules:\n      - alert: A\n        expr: up == 0\n        annotations: {a: x, b: x, c: x, d: x, e: x, f: x}\n",
+			false,
+			[]string{"6:22:", "at most 5 annotations"},
+		},
+		{
+			"expression_too_long",
+			"groups:\n  - name: node\n    rules:\n      - alert: A\n        expr: " + strings.Repeat("up + ", 120) + "up\n",
+			false,
+			[]string{"5:15:", "at most 600 characters"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			groups, errs := parseRulesYAML(tt.input)
+			if !tt.isValid && len(errs) == 0 {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && len(errs) > 0 {
+				t.Fatalf("Should not have failed: %v", errs)
+			}
+			if tt.isValid {
+				if len(groups) == 0 {
+					t.Fatalf("No groups parsed")
+				}
+				return
+			}
+			messages := []string{}
+			for _, err := range errs {
+				messages = append(messages, err.Error())
+			}
+			joined := strings.Join(messages, "\n")
+			for _, expected := range tt.expectedErrors {
+				if !strings.Contains(joined, expected) {
+					t.Fatalf("Expected %q in errors: %s", expected, joined)
+				}
+			}
+		})
+	}
+}
+
+func TestToGroupModels(t *testing.T) {
+	groups, errs := parseRulesYAML(validRulesYAML)
+	if len(errs) > 0 {
+		t.Fatalf("Parsing rules: %v", errs)
+	}
+
+	expected := []groupModel{
+		{
+			Name:     types.StringValue("node"),
+			Interval: types.StringValue("5m"),
+			Rules: types.ListValueMust(types.ObjectType{AttrTypes: ruleTypes}, []attr.Value{
+				types.ObjectValueMust(ruleTypes, map[string]attr.Value{
+					"alert":      types.StringValue("HighLoad"),
+					"expression": types.StringValue("node_load1 > 4"),
+					"for":        types.StringValue("1m30s"),
+					"labels": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"severity": types.StringValue("warning"),
+					}),
+					"annotations": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"summary": types.StringValue("Load of {{ $labels.instance }} is high"),
+					}),
+				}),
+				types.ObjectValueMust(ruleTypes, map[string]attr.Value{
+					"alert":       types.StringValue("InstanceDown"),
+					"expression":  types.StringValue("up == 0"),
+					"for":         types.StringNull(),
+					"labels":      types.MapNull(types.StringType),
+					"annotations": types.MapNull(types.StringType),
+				}),
+			}),
+		},
+		{
+			Name:     types.StringValue("blackbox"),
+			Interval: types.StringValue("1m"),
+			Rules: types.ListValueMust(types.ObjectType{AttrTypes: ruleTypes}, []attr.Value{
+				types.ObjectValueMust(ruleTypes, map[string]attr.Value{
+					"alert":       types.StringValue("ProbeFailed"),
+					"expression":  types.StringValue("probe_success == 0"),
+					"for":         types.StringNull(),
+					"labels":      types.MapNull(types.StringType),
+					"annotations": types.MapNull(types.StringType),
+				}),
+			}),
+		},
+	}
+
+	output, err := toGroupModels(context.Background(), groups)
+	if err != nil {
+		t.Fatalf("Should not have failed: %v", err)
+	}
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+}
+
+func TestNormalizeDuration(t *testing.T) {
+	tests := []struct {
+		input    *string
+		expected types.String
+	}{
+		{nil, types.StringNull()},
+		{utils.Ptr(""), types.StringNull()},
+		{utils.Ptr("0s"), types.StringNull()},
+		{utils.Ptr("60s"), types.StringValue("1m")},
+		{utils.Ptr("1h30m"), types.StringValue("1h30m")},
+		{utils.Ptr("90m"), types.StringValue("1h30m")},
+		{utils.Ptr("invalid"), types.StringValue("invalid")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected.String(), func(t *testing.T) {
+			output := normalizeDuration(tt.input)
+			if !output.Equal(tt.expected) {
+				t.Fatalf("Expected %s, got %s", tt.expected, output)
+			}
+		})
+	}
+}
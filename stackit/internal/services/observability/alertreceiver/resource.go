@@ -0,0 +1,640 @@
+package alertreceiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertReceiverResource{}
+	_ resource.ResourceWithConfigure   = &alertReceiverResource{}
+	_ resource.ResourceWithImportState = &alertReceiverResource{}
+)
+
+type Model struct {
+	Id              types.String `tfsdk:"id"` // needed by TF
+	ProjectId       types.String `tfsdk:"project_id"`
+	InstanceId      types.String `tfsdk:"instance_id"`
+	Name            types.String `tfsdk:"name"`
+	EmailConfigs    types.List   `tfsdk:"email_configs"`
+	OpsGenieConfigs types.List   `tfsdk:"opsgenie_configs"`
+	WebHooksConfigs types.List   `tfsdk:"webhooks_configs"`
+}
+
+// Struct corresponding to Model.EmailConfigs
+type emailConfigsModel struct {
+	AuthIdentity types.String `tfsdk:"auth_identity"`
+	AuthPassword types.String `tfsdk:"auth_password"`
+	AuthUsername types.String `tfsdk:"auth_username"`
+	From         types.String `tfsdk:"from"`
+	Smarthost    types.String `tfsdk:"smart_host"`
+	To           types.String `tfsdk:"to"`
+}
+
+var emailConfigsTypes = map[string]attr.Type{
+	"auth_identity": types.StringType,
+	"auth_password": types.StringType,
+	"auth_username": types.StringType,
+	"from":          types.StringType,
+	"smart_host":    types.StringType,
+	"to":            types.StringType,
+}
+
+// Struct corresponding to Model.OpsGenieConfigs
+type opsgenieConfigsModel struct {
+	ApiKey types.String `tfsdk:"api_key"`
+	ApiUrl types.String `tfsdk:"api_url"`
+	Tags   types.String `tfsdk:"tags"`
+}
+
+var opsgenieConfigsTypes = map[string]attr.Type{
+	"api_key": types.StringType,
+	"api_url": types.StringType,
+	"tags":    types.StringType,
+}
+
+// Struct corresponding to Model.WebHooksConfigs
+type webHooksConfigsModel struct {
+	Url     types.String `tfsdk:"url"`
+	MsTeams types.Bool   `tfsdk:"ms_teams"`
+}
+
+var webHooksConfigsTypes = map[string]attr.Type{
+	"url":      types.StringType,
+	"ms_teams": types.BoolType,
+}
+
+// Descriptions for the resource schema are centralized here.
+var descriptions = map[string]string{
+	"main":             "Observability alert receiver resource schema. Manages a single receiver of the alert config of an observability instance, independently of the `alert_config` of the `stackit_observability_instance` resource. Must have a `region` specified in the provider configuration.",
+	"id":               "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`name`\".",
+	"project_id":       "STACKIT project ID to which the alert receiver is associated.",
+	"instance_id":      "Observability instance ID to which the alert receiver is associated.",
+	"name":             "Name of the receiver. Is the identifier and must be unique in the alert config of the instance.",
+	"email_configs":    "List of email configurations.",
+	"opsgenie_configs": "List of OpsGenie configurations.",
+	"webhooks_configs": "List of Webhooks configurations.",
+}
+
+// NewAlertReceiverResource is a helper function to simplify the provider implementation.
+func NewAlertReceiverResource() resource.Resource {
+	return &alertReceiverResource{}
+}
+
+// alertReceiverResource is the resource implementation.
+type alertReceiverResource struct {
+	client *observability.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *alertReceiverResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_alert_receiver"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertReceiverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Observability alert receiver client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *alertReceiverResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: descriptions["name"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.LengthBetween(1, 200),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"email_configs": schema.ListNestedAttribute{
+				Description: descriptions["email_configs"],
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"auth_identity": schema.StringAttribute{
+							Description: "SMTP authentication information. Must be a valid email address",
+							Optional:    true,
+						},
+						"auth_password": schema.StringAttribute{
+							Description: "SMTP authentication password.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"auth_username": schema.StringAttribute{
+							Description: "SMTP authentication username.",
+							Optional:    true,
+						},
+						"from": schema.StringAttribute{
+							Description: "The sender email address. Must be a valid email address",
+							Optional:    true,
+						},
+						"smart_host": schema.StringAttribute{
+							Description: "The SMTP host through which emails are sent.",
+							Optional:    true,
+						},
+						"to": schema.StringAttribute{
+							Description: "The email address to send notifications to. Must be a valid email address",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"opsgenie_configs": schema.ListNestedAttribute{
+				Description: descriptions["opsgenie_configs"],
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"api_key": schema.StringAttribute{
+							Description: "The API key for OpsGenie.",
+							Optional:    true,
+							Sensitive:   true,
+						},
+						"api_url": schema.StringAttribute{
+							Description: "The host to send OpsGenie API requests to. Must be a valid URL",
+							Optional:    true,
+						},
+						"tags": schema.StringAttribute{
+							Description: "Comma separated list of tags attached to the notifications.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"webhooks_configs": schema.ListNestedAttribute{
+				Description: descriptions["webhooks_configs"],
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "The endpoint to send HTTP POST requests to. Must be a valid URL",
+							Optional:    true,
+						},
+						"ms_teams": schema.BoolAttribute{
+							Description: "Microsoft Teams webhooks require special handling, set this to true if the webhook is for Microsoft Teams.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertReceiverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver_name", name)
+
+	payload, err := toCreatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert receiver", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	createResp, err := r.client.CreateAlertConfigReceiver(ctx, instanceId, projectId).CreateAlertConfigReceiverPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert receiver", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// all receivers are returned. We have to search the list for the one corresponding to our name
+	var receiver *observability.Receivers
+	if createResp != nil && createResp.Data != nil {
+		for i := range *createResp.Data {
+			if (*createResp.Data)[i].Name != nil && *(*createResp.Data)[i].Name == name {
+				receiver = &(*createResp.Data)[i]
+				break
+			}
+		}
+	}
+	if receiver == nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert receiver", "Receiver not found in API response")
+		return
+	}
+
+	err = mapFields(ctx, receiver, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert receiver", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set the state with fully populated data.
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability alert receiver created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertReceiverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver_name", name)
+
+	receiverResp, err := r.client.GetAlertConfigReceiverExecute(ctx, instanceId, projectId, name)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading alert receiver", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, receiverResp.Data, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading alert receiver", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set the updated state.
+	diags = resp.State.Set(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability alert receiver read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertReceiverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver_name", name)
+
+	payload, err := toUpdatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert receiver", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.UpdateAlertConfigReceiver(ctx, instanceId, projectId, name).UpdateAlertConfigReceiverPayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert receiver", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	receiverResp, err := r.client.GetAlertConfigReceiverExecute(ctx, instanceId, projectId, name)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert receiver", fmt.Sprintf("Calling API to get the updated receiver: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, receiverResp.Data, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert receiver", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability alert receiver updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertReceiverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	name := model.Name.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver_name", name)
+
+	_, err := r.client.DeleteAlertConfigReceiverExecute(ctx, instanceId, projectId, name)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Observability alert receiver already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting alert receiver", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Observability alert receiver deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,instance_id,name
+func (r *alertReceiverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing alert receiver",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id],[name]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[2])...)
+	tflog.Info(ctx, "Observability alert receiver state imported")
+}
+
+// mapFields maps the receiver of the API response to the model.
+func mapFields(ctx context.Context, receiver *observability.Receivers, model *Model) error {
+	if receiver == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var name string
+	if model.Name.ValueString() != "" {
+		name = model.Name.ValueString()
+	} else if receiver.Name != nil {
+		name = *receiver.Name
+	} else {
+		return fmt.Errorf("receiver name not present")
+	}
+	model.Name = types.StringValue(name)
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString(), name)
+
+	emailConfigList := []attr.Value{}
+	if receiver.EmailConfigs != nil {
+		for _, emailConfig := range *receiver.EmailConfigs {
+			emailConfigModel, diags := types.ObjectValue(emailConfigsTypes, map[string]attr.Value{
+				"auth_identity": types.StringPointerValue(emailConfig.AuthIdentity),
+				"auth_password": types.StringPointerValue(emailConfig.AuthPassword),
+				"auth_username": types.StringPointerValue(emailConfig.AuthUsername),
+				"from":          types.StringPointerValue(emailConfig.From),
+				"smart_host":    types.StringPointerValue(emailConfig.Smarthost),
+				"to":            types.StringPointerValue(emailConfig.To),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping email config: %w", core.DiagsToError(diags))
+			}
+			emailConfigList = append(emailConfigList, emailConfigModel)
+		}
+	}
+	emailConfigs, err := toListValue(ctx, emailConfigsTypes, emailConfigList)
+	if err != nil {
+		return fmt.Errorf("mapping email configs: %w", err)
+	}
+	model.EmailConfigs = emailConfigs
+
+	opsgenieConfigList := []attr.Value{}
+	if receiver.OpsgenieConfigs != nil {
+		for _, opsgenieConfig := range *receiver.OpsgenieConfigs {
+			opsgenieConfigModel, diags := types.ObjectValue(opsgenieConfigsTypes, map[string]attr.Value{
+				"api_key": types.StringPointerValue(opsgenieConfig.ApiKey),
+				"api_url": types.StringPointerValue(opsgenieConfig.ApiUrl),
+				"tags":    types.StringPointerValue(opsgenieConfig.Tags),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping opsgenie config: %w", core.DiagsToError(diags))
+			}
+			opsgenieConfigList = append(opsgenieConfigList, opsgenieConfigModel)
+		}
+	}
+	opsgenieConfigs, err := toListValue(ctx, opsgenieConfigsTypes, opsgenieConfigList)
+	if err != nil {
+		return fmt.Errorf("mapping opsgenie configs: %w", err)
+	}
+	model.OpsGenieConfigs = opsgenieConfigs
+
+	webHooksConfigList := []attr.Value{}
+	if receiver.WebHookConfigs != nil {
+		for _, webHookConfig := range *receiver.WebHookConfigs {
+			webHookConfigModel, diags := types.ObjectValue(webHooksConfigsTypes, map[string]attr.Value{
+				"url":      types.StringPointerValue(webHookConfig.Url),
+				"ms_teams": types.BoolPointerValue(webHookConfig.MsTeams),
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping webhooks config: %w", core.DiagsToError(diags))
+			}
+			webHooksConfigList = append(webHooksConfigList, webHookConfigModel)
+		}
+	}
+	webHooksConfigs, err := toListValue(ctx, webHooksConfigsTypes, webHooksConfigList)
+	if err != nil {
+		return fmt.Errorf("mapping webhooks configs: %w", err)
+	}
+	model.WebHooksConfigs = webHooksConfigs
+
+	return nil
+}
+
+// toListValue returns a list of objects with the given attribute types, or a null list if there are no elements
+func toListValue(ctx context.Context, attrTypes map[string]attr.Type, elements []attr.Value) (types.List, error) {
+	if len(elements) == 0 {
+		return types.ListNull(types.ObjectType{AttrTypes: attrTypes}), nil
+	}
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: attrTypes}, elements)
+	if diags.HasError() {
+		return types.ListNull(types.ObjectType{AttrTypes: attrTypes}), core.DiagsToError(diags)
+	}
+	return list, nil
+}
+
+// toCreatePayload generates the payload to create a new receiver.
+func toCreatePayload(ctx context.Context, model *Model) (*observability.CreateAlertConfigReceiverPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	payload := observability.CreateAlertConfigReceiverPayload{
+		Name: conversion.StringValueToPointer(model.Name),
+	}
+
+	if !utils.IsUndefined(model.EmailConfigs) {
+		emailConfigs := []emailConfigsModel{}
+		diags := model.EmailConfigs.ElementsAs(ctx, &emailConfigs, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping email configs: %w", core.DiagsToError(diags))
+		}
+		payloadEmailConfigs := []observability.CreateAlertConfigReceiverPayloadEmailConfigsInner{}
+		for i := range emailConfigs {
+			emailConfig := emailConfigs[i]
+			payloadEmailConfigs = append(payloadEmailConfigs, observability.CreateAlertConfigReceiverPayloadEmailConfigsInner{
+				AuthIdentity: conversion.StringValueToPointer(emailConfig.AuthIdentity),
+				AuthPassword: conversion.StringValueToPointer(emailConfig.AuthPassword),
+				AuthUsername: conversion.StringValueToPointer(emailConfig.AuthUsername),
+				From:         conversion.StringValueToPointer(emailConfig.From),
+				Smarthost:    conversion.StringValueToPointer(emailConfig.Smarthost),
+				To:           conversion.StringValueToPointer(emailConfig.To),
+			})
+		}
+		payload.EmailConfigs = &payloadEmailConfigs
+	}
+
+	if !utils.IsUndefined(model.OpsGenieConfigs) {
+		opsgenieConfigs := []opsgenieConfigsModel{}
+		diags := model.OpsGenieConfigs.ElementsAs(ctx, &opsgenieConfigs, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping opsgenie configs: %w", core.DiagsToError(diags))
+		}
+		payloadOpsGenieConfigs := []observability.CreateAlertConfigReceiverPayloadOpsgenieConfigsInner{}
+		for i := range opsgenieConfigs {
+			opsgenieConfig := opsgenieConfigs[i]
+			payloadOpsGenieConfigs = append(payloadOpsGenieConfigs, observability.CreateAlertConfigReceiverPayloadOpsgenieConfigsInner{
+				ApiKey: conversion.StringValueToPointer(opsgenieConfig.ApiKey),
+				ApiUrl: conversion.StringValueToPointer(opsgenieConfig.ApiUrl),
+				Tags:   conversion.StringValueToPointer(opsgenieConfig.Tags),
+			})
+		}
+		payload.OpsgenieConfigs = &payloadOpsGenieConfigs
+	}
+
+	if !utils.IsUndefined(model.WebHooksConfigs) {
+		webHooksConfigs := []webHooksConfigsModel{}
+		diags := model.WebHooksConfigs.ElementsAs(ctx, &webHooksConfigs, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping webhooks configs: %w", core.DiagsToError(diags))
+		}
+		payloadWebHooksConfigs := []observability.CreateAlertConfigReceiverPayloadWebHookConfigsInner{}
+		for i := range webHooksConfigs {
+			webHooksConfig := webHooksConfigs[i]
+			payloadWebHooksConfigs = append(payloadWebHooksConfigs, observability.CreateAlertConfigReceiverPayloadWebHookConfigsInner{
+				Url:     conversion.StringValueToPointer(webHooksConfig.Url),
+				MsTeams: conversion.BoolValueToPointer(webHooksConfig.MsTeams),
+			})
+		}
+		payload.WebHookConfigs = &payloadWebHooksConfigs
+	}
+
+	return &payload, nil
+}
+
+// toUpdatePayload generates the payload to update a receiver.
+func toUpdatePayload(ctx context.Context, model *Model) (*observability.UpdateAlertConfigReceiverPayload, error) {
+	createPayload, err := toCreatePayload(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return &observability.UpdateAlertConfigReceiverPayload{
+		Name:            createPayload.Name,
+		EmailConfigs:    createPayload.EmailConfigs,
+		OpsgenieConfigs: createPayload.OpsgenieConfigs,
+		WebHookConfigs:  createPayload.WebHookConfigs,
+	}, nil
+}
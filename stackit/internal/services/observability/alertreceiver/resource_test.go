@@ -0,0 +1,237 @@
+package alertreceiver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func fixtureEmailConfigsModel() types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: emailConfigsTypes}, []attr.Value{
+		types.ObjectValueMust(emailConfigsTypes, map[string]attr.Value{
+			"auth_identity": types.StringValue("identity"),
+			"auth_password": types.StringValue("password"),
+			"auth_username": types.StringValue("username"),
+			"from":          types.StringValue("notification@example.com"),
+			"smart_host":    types.StringValue("smtp.example.com"),
+			"to":            types.StringValue("me@example.com"),
+		}),
+	})
+}
+
+func fixtureOpsGenieConfigsModel() types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: opsgenieConfigsTypes}, []attr.Value{
+		types.ObjectValueMust(opsgenieConfigsTypes, map[string]attr.Value{
+			"api_key": types.StringValue("key"),
+			"api_url": types.StringValue("ops.example.com"),
+			"tags":    types.StringValue("tag"),
+		}),
+	})
+}
+
+func fixtureWebHooksConfigsModel() types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: webHooksConfigsTypes}, []attr.Value{
+		types.ObjectValueMust(webHooksConfigsTypes, map[string]attr.Value{
+			"url":      types.StringValue("http://example.com"),
+			"ms_teams": types.BoolValue(true),
+		}),
+	})
+}
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observability.Receivers
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&observability.Receivers{
+				Name: utils.Ptr("name"),
+			},
+			Model{
+				Id:              types.StringValue("pid,iid,name"),
+				ProjectId:       types.StringValue("pid"),
+				InstanceId:      types.StringValue("iid"),
+				Name:            types.StringValue("name"),
+				EmailConfigs:    types.ListNull(types.ObjectType{AttrTypes: emailConfigsTypes}),
+				OpsGenieConfigs: types.ListNull(types.ObjectType{AttrTypes: opsgenieConfigsTypes}),
+				WebHooksConfigs: types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
+			},
+			true,
+		},
+		{
+			"values_ok",
+			&observability.Receivers{
+				Name: utils.Ptr("name"),
+				EmailConfigs: &[]observability.EmailConfig{
+					{
+						AuthIdentity: utils.Ptr("identity"),
+						AuthPassword: utils.Ptr("password"),
+						AuthUsername: utils.Ptr("username"),
+						From:         utils.Ptr("notification@example.com"),
+						Smarthost:    utils.Ptr("smtp.example.com"),
+						To:           utils.Ptr("me@example.com"),
+					},
+				},
+				OpsgenieConfigs: &[]observability.OpsgenieConfig{
+					{
+						ApiKey: utils.Ptr("key"),
+						ApiUrl: utils.Ptr("ops.example.com"),
+						Tags:   utils.Ptr("tag"),
+					},
+				},
+				WebHookConfigs: &[]observability.WebHook{
+					{
+						Url:     utils.Ptr("http://example.com"),
+						MsTeams: utils.Ptr(true),
+					},
+				},
+			},
+			Model{
+				Id:              types.StringValue("pid,iid,name"),
+				ProjectId:       types.StringValue("pid"),
+				InstanceId:      types.StringValue("iid"),
+				Name:            types.StringValue("name"),
+				EmailConfigs:    fixtureEmailConfigsModel(),
+				OpsGenieConfigs: fixtureOpsGenieConfigsModel(),
+				WebHooksConfigs: fixtureWebHooksConfigsModel(),
+			},
+			true,
+		},
+		{
+			"empty_configs",
+			&observability.Receivers{
+				Name:            utils.Ptr("name"),
+				EmailConfigs:    &[]observability.EmailConfig{},
+				OpsgenieConfigs: &[]observability.OpsgenieConfig{},
+				WebHookConfigs:  &[]observability.WebHook{},
+			},
+			Model{
+				Id:              types.StringValue("pid,iid,name"),
+				ProjectId:       types.StringValue("pid"),
+				InstanceId:      types.StringValue("iid"),
+				Name:            types.StringValue("name"),
+				EmailConfigs:    types.ListNull(types.ObjectType{AttrTypes: emailConfigsTypes}),
+				OpsGenieConfigs: types.ListNull(types.ObjectType{AttrTypes: opsgenieConfigsTypes}),
+				WebHooksConfigs: types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:  tt.expected.ProjectId,
+				InstanceId: tt.expected.InstanceId,
+			}
+			err := mapFields(context.Background(), tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *observability.CreateAlertConfigReceiverPayload
+		isValid     bool
+	}{
+		{
+			"basic_ok",
+			&Model{
+				Name:            types.StringValue("name"),
+				EmailConfigs:    types.ListNull(types.ObjectType{AttrTypes: emailConfigsTypes}),
+				OpsGenieConfigs: types.ListNull(types.ObjectType{AttrTypes: opsgenieConfigsTypes}),
+				WebHooksConfigs: types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
+			},
+			&observability.CreateAlertConfigReceiverPayload{
+				Name: utils.Ptr("name"),
+			},
+			true,
+		},
+		{
+			"values_ok",
+			&Model{
+				Name:            types.StringValue("name"),
+				EmailConfigs:    fixtureEmailConfigsModel(),
+				OpsGenieConfigs: fixtureOpsGenieConfigsModel(),
+				WebHooksConfigs: fixtureWebHooksConfigsModel(),
+			},
+			&observability.CreateAlertConfigReceiverPayload{
+				Name: utils.Ptr("name"),
+				EmailConfigs: &[]observability.CreateAlertConfigReceiverPayloadEmailConfigsInner{
+					{
+						AuthIdentity: utils.Ptr("identity"),
+						AuthPassword: utils.Ptr("password"),
+						AuthUsername: utils.Ptr("username"),
+						From:         utils.Ptr("notification@example.com"),
+						Smarthost:    utils.Ptr("smtp.example.com"),
+						To:           utils.Ptr("me@example.com"),
+					},
+				},
+				OpsgenieConfigs: &[]observability.CreateAlertConfigReceiverPayloadOpsgenieConfigsInner{
+					{
+						ApiKey: utils.Ptr("key"),
+						ApiUrl: utils.Ptr("ops.example.com"),
+						Tags:   utils.Ptr("tag"),
+					},
+				},
+				WebHookConfigs: &[]observability.CreateAlertConfigReceiverPayloadWebHookConfigsInner{
+					{
+						Url:     utils.Ptr("http://example.com"),
+						MsTeams: utils.Ptr(true),
+					},
+				},
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
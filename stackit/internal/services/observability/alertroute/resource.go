@@ -0,0 +1,519 @@
+package alertroute
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &alertRouteResource{}
+	_ resource.ResourceWithConfigure   = &alertRouteResource{}
+	_ resource.ResourceWithImportState = &alertRouteResource{}
+)
+
+type Model struct {
+	Id             types.String `tfsdk:"id"` // needed by TF
+	ProjectId      types.String `tfsdk:"project_id"`
+	InstanceId     types.String `tfsdk:"instance_id"`
+	Receiver       types.String `tfsdk:"receiver"`
+	Continue       types.Bool   `tfsdk:"continue"`
+	GroupBy        types.List   `tfsdk:"group_by"`
+	GroupInterval  types.String `tfsdk:"group_interval"`
+	GroupWait      types.String `tfsdk:"group_wait"`
+	Match          types.Map    `tfsdk:"match"`
+	MatchRegex     types.Map    `tfsdk:"match_regex"`
+	Matchers       types.List   `tfsdk:"matchers"`
+	RepeatInterval types.String `tfsdk:"repeat_interval"`
+}
+
+// Descriptions for the resource schema are centralized here.
+var descriptions = map[string]string{
+	"main":            "Observability alert route resource schema. Manages a single child route of the root route of the alert config of an observability instance, independently of the `alert_config` of the `stackit_observability_instance` resource. Must have a `region` specified in the provider configuration.",
+	"id":              "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`receiver`\".",
+	"project_id":      "STACKIT project ID to which the alert route is associated.",
+	"instance_id":     "Observability instance ID to which the alert route is associated.",
+	"receiver":        "The name of the receiver to route the alerts to. Is the identifier of the route and must be unique in the child routes of the instance. The receiver must exist, e.g. be managed by a `stackit_observability_alert_receiver` resource.",
+	"continue":        "Whether an alert should continue matching subsequent sibling routes. Defaults to `false`.",
+	"group_by":        "The labels by which incoming alerts are grouped together. For example, multiple alerts coming in for cluster=A and alertname=LatencyHigh would be batched into a single group. To aggregate by all possible labels use the special value '...' as the sole label name, for example: group_by: ['...']. This effectively disables aggregation entirely, passing through all alerts as-is. This is unlikely to be what you want, unless you have a very low alert volume or your upstream notification system performs its own grouping.",
+	"group_interval":  "How long to wait before sending a notification about new alerts that are added to a group of alerts for which an initial notification has already been sent. (Usually ~5m or more.)",
+	"group_wait":      "How long to initially wait to send a notification for a group of alerts. Allows to wait for an inhibiting alert to arrive or collect more initial alerts for the same group. (Usually ~0s to few minutes.)",
+	"match":           "A set of equality matchers an alert has to fulfill to match the node.",
+	"match_regex":     "A set of regex-matchers an alert has to fulfill to match the node.",
+	"matchers":        "A list of matchers that an alert has to fulfill to match the node, e.g. `severity=\"critical\"`.",
+	"repeat_interval": "How long to wait before sending a notification again if it has already been sent successfully for an alert. (Usually ~3h or more).",
+}
+
+// NewAlertRouteResource is a helper function to simplify the provider implementation.
+func NewAlertRouteResource() resource.Resource {
+	return &alertRouteResource{}
+}
+
+// alertRouteResource is the resource implementation.
+type alertRouteResource struct {
+	client *observability.APIClient
+}
+
+// Metadata returns the resource type name.
+func (r *alertRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_alert_route"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *alertRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "Observability alert route client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *alertRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"receiver": schema.StringAttribute{
+				Description: descriptions["receiver"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.LengthBetween(1, 200),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"continue": schema.BoolAttribute{
+				Description: descriptions["continue"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"group_by": schema.ListAttribute{
+				Description: descriptions["group_by"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"group_interval": schema.StringAttribute{
+				Description: descriptions["group_interval"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_wait": schema.StringAttribute{
+				Description: descriptions["group_wait"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"match": schema.MapAttribute{
+				Description: descriptions["match"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"match_regex": schema.MapAttribute{
+				Description: descriptions["match_regex"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"matchers": schema.ListAttribute{
+				Description: descriptions["matchers"],
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"repeat_interval": schema.StringAttribute{
+				Description: descriptions["repeat_interval"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *alertRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	receiver := model.Receiver.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver", receiver)
+
+	payload, err := toCreatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert route", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.CreateAlertConfigRoute(ctx, instanceId, projectId).CreateAlertConfigRoutePayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert route", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	routeResp, err := r.client.GetAlertConfigRouteExecute(ctx, instanceId, projectId, receiver)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert route", fmt.Sprintf("Calling API to get the created route: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, routeResp.Data, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating alert route", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set the state with fully populated data.
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability alert route created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *alertRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	receiver := model.Receiver.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver", receiver)
+
+	routeResp, err := r.client.GetAlertConfigRouteExecute(ctx, instanceId, projectId, receiver)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		ok := errors.As(err, &oapiErr)
+		if ok && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading alert route", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, routeResp.Data, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading alert route", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set the updated state.
+	diags = resp.State.Set(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability alert route read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *alertRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	receiver := model.Receiver.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver", receiver)
+
+	payload, err := toUpdatePayload(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert route", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+
+	_, err = r.client.UpdateAlertConfigRoute(ctx, instanceId, projectId, receiver).UpdateAlertConfigRoutePayload(*payload).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert route", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	routeResp, err := r.client.GetAlertConfigRouteExecute(ctx, instanceId, projectId, receiver)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert route", fmt.Sprintf("Calling API to get the updated route: %v", err))
+		return
+	}
+
+	err = mapFields(ctx, routeResp.Data, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating alert route", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability alert route updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *alertRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	receiver := model.Receiver.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "receiver", receiver)
+
+	_, err := r.client.DeleteAlertConfigRouteExecute(ctx, instanceId, projectId, receiver)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "Observability alert route already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting alert route", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	tflog.Info(ctx, "Observability alert route deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,instance_id,receiver
+func (r *alertRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing alert route",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id],[receiver]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("receiver"), idParts[2])...)
+	tflog.Info(ctx, "Observability alert route state imported")
+}
+
+// mapFields maps the route of the API response to the model.
+func mapFields(ctx context.Context, route *observability.Route, model *Model) error {
+	if route == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var receiver string
+	if model.Receiver.ValueString() != "" {
+		receiver = model.Receiver.ValueString()
+	} else if route.Receiver != nil {
+		receiver = *route.Receiver
+	} else {
+		return fmt.Errorf("route receiver not present")
+	}
+	model.Receiver = types.StringValue(receiver)
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString(), receiver)
+
+	groupBy, diags := types.ListValueFrom(ctx, types.StringType, route.GroupBy)
+	if diags.HasError() {
+		return fmt.Errorf("mapping group by: %w", core.DiagsToError(diags))
+	}
+	match, diags := types.MapValueFrom(ctx, types.StringType, route.Match)
+	if diags.HasError() {
+		return fmt.Errorf("mapping match: %w", core.DiagsToError(diags))
+	}
+	matchRegex, diags := types.MapValueFrom(ctx, types.StringType, route.MatchRe)
+	if diags.HasError() {
+		return fmt.Errorf("mapping match regex: %w", core.DiagsToError(diags))
+	}
+	matchers, diags := types.ListValueFrom(ctx, types.StringType, route.Matchers)
+	if diags.HasError() {
+		return fmt.Errorf("mapping matchers: %w", core.DiagsToError(diags))
+	}
+
+	model.Continue = types.BoolValue(route.GetContinue())
+	model.GroupBy = groupBy
+	model.GroupInterval = types.StringPointerValue(route.GroupInterval)
+	model.GroupWait = types.StringPointerValue(route.GroupWait)
+	model.Match = match
+	model.MatchRegex = matchRegex
+	model.Matchers = matchers
+	model.RepeatInterval = types.StringPointerValue(route.RepeatInterval)
+	return nil
+}
+
+// toCreatePayload generates the payload to create a new child route.
+func toCreatePayload(ctx context.Context, model *Model) (*observability.CreateAlertConfigRoutePayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+
+	payload := observability.CreateAlertConfigRoutePayload{
+		Continue:       conversion.BoolValueToPointer(model.Continue),
+		GroupInterval:  conversion.StringValueToPointer(model.GroupInterval),
+		GroupWait:      conversion.StringValueToPointer(model.GroupWait),
+		Receiver:       conversion.StringValueToPointer(model.Receiver),
+		RepeatInterval: conversion.StringValueToPointer(model.RepeatInterval),
+	}
+
+	if !utils.IsUndefined(model.GroupBy) {
+		groupBy := []string{}
+		diags := model.GroupBy.ElementsAs(ctx, &groupBy, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping group by: %w", core.DiagsToError(diags))
+		}
+		payload.GroupBy = &groupBy
+	}
+
+	if !utils.IsUndefined(model.Match) {
+		match, err := conversion.ToStringInterfaceMap(ctx, model.Match)
+		if err != nil {
+			return nil, fmt.Errorf("mapping match: %w", err)
+		}
+		payload.Match = &match
+	}
+
+	if !utils.IsUndefined(model.MatchRegex) {
+		matchRegex, err := conversion.ToStringInterfaceMap(ctx, model.MatchRegex)
+		if err != nil {
+			return nil, fmt.Errorf("mapping match regex: %w", err)
+		}
+		payload.MatchRe = &matchRegex
+	}
+
+	if !utils.IsUndefined(model.Matchers) {
+		matchers := []string{}
+		diags := model.Matchers.ElementsAs(ctx, &matchers, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping matchers: %w", core.DiagsToError(diags))
+		}
+		payload.Matchers = &matchers
+	}
+
+	return &payload, nil
+}
+
+// toUpdatePayload generates the payload to update a child route.
+func toUpdatePayload(ctx context.Context, model *Model) (*observability.UpdateAlertConfigRoutePayload, error) {
+	createPayload, err := toCreatePayload(ctx, model)
+	if err != nil {
+		return nil, err
+	}
+	return &observability.UpdateAlertConfigRoutePayload{
+		Continue:       createPayload.Continue,
+		GroupBy:        createPayload.GroupBy,
+		GroupInterval:  createPayload.GroupInterval,
+		GroupWait:      createPayload.GroupWait,
+		Match:          createPayload.Match,
+		MatchRe:        createPayload.MatchRe,
+		Matchers:       createPayload.Matchers,
+		Receiver:       createPayload.Receiver,
+		RepeatInterval: createPayload.RepeatInterval,
+	}, nil
+}
@@ -0,0 +1,185 @@
+package alertroute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observability.Route
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&observability.Route{
+				Receiver: utils.Ptr("team-a"),
+			},
+			Model{
+				Id:             types.StringValue("pid,iid,team-a"),
+				ProjectId:      types.StringValue("pid"),
+				InstanceId:     types.StringValue("iid"),
+				Receiver:       types.StringValue("team-a"),
+				Continue:       types.BoolValue(false),
+				GroupBy:        types.ListNull(types.StringType),
+				GroupInterval:  types.StringNull(),
+				GroupWait:      types.StringNull(),
+				Match:          types.MapNull(types.StringType),
+				MatchRegex:     types.MapNull(types.StringType),
+				Matchers:       types.ListNull(types.StringType),
+				RepeatInterval: types.StringNull(),
+			},
+			true,
+		},
+		{
+			"values_ok",
+			&observability.Route{
+				Receiver:       utils.Ptr("team-a"),
+				Continue:       utils.Ptr(true),
+				GroupBy:        &[]string{"alertname"},
+				GroupInterval:  utils.Ptr("5m"),
+				GroupWait:      utils.Ptr("30s"),
+				Match:          &map[string]string{"team": "a"},
+				MatchRe:        &map[string]string{"service": "api|web"},
+				Matchers:       &[]string{"severity=\"critical\""},
+				RepeatInterval: utils.Ptr("4h"),
+			},
+			Model{
+				Id:            types.StringValue("pid,iid,team-a"),
+				ProjectId:     types.StringValue("pid"),
+				InstanceId:    types.StringValue("iid"),
+				Receiver:      types.StringValue("team-a"),
+				Continue:      types.BoolValue(true),
+				GroupBy:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("alertname")}),
+				GroupInterval: types.StringValue("5m"),
+				GroupWait:     types.StringValue("30s"),
+				Match: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"team": types.StringValue("a"),
+				}),
+				MatchRegex: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"service": types.StringValue("api|web"),
+				}),
+				Matchers:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("severity=\"critical\"")}),
+				RepeatInterval: types.StringValue("4h"),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:  tt.expected.ProjectId,
+				InstanceId: tt.expected.InstanceId,
+			}
+			err := mapFields(context.Background(), tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToCreatePayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *observability.CreateAlertConfigRoutePayload
+		isValid     bool
+	}{
+		{
+			"basic_ok",
+			&Model{
+				Receiver:       types.StringValue("team-a"),
+				Continue:       types.BoolValue(false),
+				GroupBy:        types.ListNull(types.StringType),
+				GroupInterval:  types.StringUnknown(),
+				GroupWait:      types.StringUnknown(),
+				Match:          types.MapNull(types.StringType),
+				MatchRegex:     types.MapNull(types.StringType),
+				Matchers:       types.ListNull(types.StringType),
+				RepeatInterval: types.StringUnknown(),
+			},
+			&observability.CreateAlertConfigRoutePayload{
+				Receiver: utils.Ptr("team-a"),
+				Continue: utils.Ptr(false),
+			},
+			true,
+		},
+		{
+			"values_ok",
+			&Model{
+				Receiver:      types.StringValue("team-a"),
+				Continue:      types.BoolValue(true),
+				GroupBy:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("alertname")}),
+				GroupInterval: types.StringValue("5m"),
+				GroupWait:     types.StringValue("30s"),
+				Match: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"team": types.StringValue("a"),
+				}),
+				MatchRegex: types.MapValueMust(types.StringType, map[string]attr.Value{
+					"service": types.StringValue("api|web"),
+				}),
+				Matchers:       types.ListValueMust(types.StringType, []attr.Value{types.StringValue("severity=\"critical\"")}),
+				RepeatInterval: types.StringValue("4h"),
+			},
+			&observability.CreateAlertConfigRoutePayload{
+				Receiver:       utils.Ptr("team-a"),
+				Continue:       utils.Ptr(true),
+				GroupBy:        &[]string{"alertname"},
+				GroupInterval:  utils.Ptr("5m"),
+				GroupWait:      utils.Ptr("30s"),
+				Match:          &map[string]interface{}{"team": "a"},
+				MatchRe:        &map[string]interface{}{"service": "api|web"},
+				Matchers:       &[]string{"severity=\"critical\""},
+				RepeatInterval: utils.Ptr("4h"),
+			},
+			true,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toCreatePayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &credentialsDataSource{}
+)
+
+type DataSourceModel struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	Usernames  types.List   `tfsdk:"usernames"`
+}
+
+// NewCredentialsDataSource is a helper function to simplify the provider implementation.
+func NewCredentialsDataSource() datasource.DataSource {
+	return &credentialsDataSource{}
+}
+
+// credentialsDataSource is the data source implementation.
+type credentialsDataSource struct {
+	client *observability.APIClient
+}
+
+// Metadata returns the data source type name.
+func (d *credentialsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_credentials"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *credentialsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "Observability credentials client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *credentialsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Observability credentials data source schema. Lists the usernames of all credentials of an Observability instance, including credentials not managed by Terraform. Must have a `region` specified in the provider configuration.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`project_id`,`instance_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the credentials are associated.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: "The Observability Instance ID the credentials belong to.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"usernames": schema.ListAttribute{
+				Description: "The usernames of the credentials, sorted alphabetically.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *credentialsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model DataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	credentialsResp, err := d.client.ListCredentials(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading credentials",
+			fmt.Sprintf("Instance with ID %q does not exist in project %q.", instanceId, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapDataSourceFields(ctx, credentialsResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading credentials", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability credentials read")
+}
+
+func mapDataSourceFields(ctx context.Context, r *observability.ListCredentialsResponse, model *DataSourceModel) error {
+	if r == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString())
+
+	usernames := []string{}
+	if r.Credentials != nil {
+		for i, credential := range *r.Credentials {
+			username := credentialUsername(&credential)
+			if username == "" {
+				return fmt.Errorf("credential %d has no username", i)
+			}
+			usernames = append(usernames, username)
+		}
+	}
+	sort.Strings(usernames)
+
+	usernamesTF, diags := types.ListValueFrom(ctx, types.StringType, usernames)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Usernames = usernamesTF
+	return nil
+}
+
+// credentialUsername returns the username of a listed credential. The username is part of the credentials info,
+// if that is missing the name of the credential is used, which matches the username.
+func credentialUsername(credential *observability.ServiceKeysList) string {
+	if credential.CredentialsInfo != nil {
+		if username, ok := (*credential.CredentialsInfo)["username"]; ok && username != "" {
+			return username
+		}
+	}
+	return credential.GetName()
+}
@@ -0,0 +1,98 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func TestMapDataSourceFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observability.ListCredentialsResponse
+		expected    DataSourceModel
+		isValid     bool
+	}{
+		{
+			"ok",
+			&observability.ListCredentialsResponse{
+				Credentials: &[]observability.ServiceKeysList{
+					{
+						Id:              utils.Ptr("id-2"),
+						Name:            utils.Ptr("user-b"),
+						CredentialsInfo: &map[string]string{"username": "user-b"},
+					},
+					{
+						Id:   utils.Ptr("id-1"),
+						Name: utils.Ptr("user-a"),
+					},
+				},
+			},
+			DataSourceModel{
+				Id:         types.StringValue("pid,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Usernames: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("user-a"),
+					types.StringValue("user-b"),
+				}),
+			},
+			true,
+		},
+		{
+			"no_credentials",
+			&observability.ListCredentialsResponse{},
+			DataSourceModel{
+				Id:         types.StringValue("pid,iid"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Usernames:  types.ListValueMust(types.StringType, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"no_username_fail",
+			&observability.ListCredentialsResponse{
+				Credentials: &[]observability.ServiceKeysList{
+					{
+						Id: utils.Ptr("id-1"),
+					},
+				},
+			},
+			DataSourceModel{},
+			false,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			DataSourceModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &DataSourceModel{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+			}
+			err := mapDataSourceFields(context.Background(), tt.input, state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
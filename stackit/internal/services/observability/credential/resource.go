@@ -24,16 +24,19 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &credentialResource{}
-	_ resource.ResourceWithConfigure = &credentialResource{}
+	_ resource.Resource               = &credentialResource{}
+	_ resource.ResourceWithConfigure  = &credentialResource{}
+	_ resource.ResourceWithModifyPlan = &credentialResource{}
 )
 
 type Model struct {
-	Id         types.String `tfsdk:"id"`
-	ProjectId  types.String `tfsdk:"project_id"`
-	InstanceId types.String `tfsdk:"instance_id"`
-	Username   types.String `tfsdk:"username"`
-	Password   types.String `tfsdk:"password"`
+	Id                types.String `tfsdk:"id"`
+	ProjectId         types.String `tfsdk:"project_id"`
+	InstanceId        types.String `tfsdk:"instance_id"`
+	Description       types.String `tfsdk:"description"`
+	RotateWhenChanged types.Map    `tfsdk:"rotate_when_changed"`
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
 }
 
 // NewCredentialResource is a helper function to simplify the provider implementation.
@@ -97,12 +100,27 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"description": schema.StringAttribute{
+				Description: "A description of the credential, e.g. its purpose or consumer. The description is only stored in the Terraform state, the Observability API doesn't keep it.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"rotate_when_changed": schema.MapAttribute{
+				Description: "A map of arbitrary key/value pairs that triggers a rotation of the credential when it changes, e.g. based on a changing timestamp. On rotation, a new credential is created before the old one is deleted.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"username": schema.StringAttribute{
 				Description: "Credential username",
 				Computed:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"password": schema.StringAttribute{
 				Description: "Credential password",
@@ -119,6 +137,33 @@ func (r *credentialResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to plan a new username and password if `rotate_when_changed` changes.
+func (r *credentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip creation and deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var stateModel, planModel Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !rotationRequired(&stateModel, &planModel) {
+		return
+	}
+	planModel.Id = types.StringUnknown()
+	planModel.Username = types.StringUnknown()
+	planModel.Password = types.StringUnknown()
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+}
+
+// rotationRequired reports whether the credential has to be rotated because `rotate_when_changed` changed.
+func rotationRequired(stateModel, planModel *Model) bool {
+	return !planModel.RotateWhenChanged.Equal(stateModel.RotateWhenChanged)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *credentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	var model Model
@@ -206,9 +251,61 @@ func (r *credentialResource) Read(ctx context.Context, req resource.ReadRequest,
 	tflog.Info(ctx, "Observability credential read")
 }
 
-func (r *credentialResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating credential", "Credential can't be updated")
+// Update updates the description of the credential, or rotates the credential if `rotate_when_changed` changed.
+func (r *credentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	var stateModel Model
+	diags = req.State.Get(ctx, &stateModel)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !rotationRequired(&stateModel, &model) {
+		model.Id = stateModel.Id
+		model.Username = stateModel.Username
+		model.Password = stateModel.Password
+		diags = resp.State.Set(ctx, model)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, "Observability credential updated")
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	oldUserName := stateModel.Username.ValueString()
+
+	// The new credential is created first, so that the old one stays usable until its successor exists
+	got, err := r.client.CreateCredentials(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating credential", fmt.Sprintf("Creating new credential: %v", err))
+		return
+	}
+	err = mapFields(got.Credentials, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating credential", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err = r.client.DeleteCredentials(ctx, instanceId, projectId, oldUserName).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating credential", fmt.Sprintf("The new credential %q was created, but the old credential %q could not be deleted and has to be deleted manually: %v", model.Username.ValueString(), oldUserName, err))
+		return
+	}
+	tflog.Info(ctx, "Observability credential rotated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/observability"
@@ -23,11 +24,12 @@ func TestMapFields(t *testing.T) {
 				Password: utils.Ptr("password"),
 			},
 			Model{
-				Id:         types.StringValue("pid,iid,username"),
-				ProjectId:  types.StringValue("pid"),
-				InstanceId: types.StringValue("iid"),
-				Username:   types.StringValue("username"),
-				Password:   types.StringValue("password"),
+				Id:                types.StringValue("pid,iid,username"),
+				ProjectId:         types.StringValue("pid"),
+				InstanceId:        types.StringValue("iid"),
+				RotateWhenChanged: types.MapNull(types.StringType),
+				Username:          types.StringValue("username"),
+				Password:          types.StringValue("password"),
 			},
 			true,
 		},
@@ -56,8 +58,9 @@ func TestMapFields(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
 			state := &Model{
-				ProjectId:  tt.expected.ProjectId,
-				InstanceId: tt.expected.InstanceId,
+				ProjectId:         tt.expected.ProjectId,
+				InstanceId:        tt.expected.InstanceId,
+				RotateWhenChanged: tt.expected.RotateWhenChanged,
 			}
 			err := mapFields(tt.input, state)
 			if !tt.isValid && err == nil {
@@ -75,3 +78,32 @@ func TestMapFields(t *testing.T) {
 		})
 	}
 }
+
+func TestRotationRequired(t *testing.T) {
+	rotation := func(value string) types.Map {
+		return types.MapValueMust(types.StringType, map[string]attr.Value{
+			"rotation": types.StringValue(value),
+		})
+	}
+	tests := []struct {
+		description string
+		state       types.Map
+		plan        types.Map
+		expected    bool
+	}{
+		{"unset", types.MapNull(types.StringType), types.MapNull(types.StringType), false},
+		{"unchanged", rotation("1"), rotation("1"), false},
+		{"changed", rotation("1"), rotation("2"), true},
+		{"added", types.MapNull(types.StringType), rotation("1"), true},
+		{"removed", rotation("1"), types.MapNull(types.StringType), true},
+		{"unknown", rotation("1"), types.MapUnknown(types.StringType), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := rotationRequired(&Model{RotateWhenChanged: tt.state}, &Model{RotateWhenChanged: tt.plan})
+			if output != tt.expected {
+				t.Fatalf("Expected %t, got %t", tt.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,548 @@
+package grafanadashboard
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &grafanaDashboardResource{}
+	_ resource.ResourceWithConfigure      = &grafanaDashboardResource{}
+	_ resource.ResourceWithImportState    = &grafanaDashboardResource{}
+	_ resource.ResourceWithValidateConfig = &grafanaDashboardResource{}
+)
+
+// volatileFields are set by Grafana on every save and are ignored when comparing dashboards.
+var volatileFields = []string{"id", "version"}
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	ConfigJSON types.String `tfsdk:"config_json"`
+	FolderUid  types.String `tfsdk:"folder_uid"`
+	Overwrite  types.Bool   `tfsdk:"overwrite"`
+	Uid        types.String `tfsdk:"uid"`
+	Url        types.String `tfsdk:"url"`
+	Version    types.Int64  `tfsdk:"version"`
+}
+
+// Descriptions for the resource schema are centralized here.
+var descriptions = map[string]string{
+	"main":        "Observability Grafana dashboard resource schema. Manages a dashboard in the Grafana of an observability instance through the Grafana HTTP API. Must have a `region` specified in the provider configuration.",
+	"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`uid`\".",
+	"project_id":  "STACKIT project ID to which the observability instance is associated.",
+	"instance_id": "Observability instance ID of the Grafana.",
+	"username":    "Username to authenticate against the Grafana, e.g. of a `stackit_observability_credential`. If not set, the initial Grafana admin credentials of the instance are used.",
+	"password":    "Password to authenticate against the Grafana. Must be set together with `username`.",
+	"config_json": "The JSON model of the dashboard, e.g. exported from the Grafana UI. The `id` and `version` fields are managed by Grafana and ignored. If the `uid` field is set, changing it replaces the dashboard.",
+	"folder_uid":  "The UID of the folder to store the dashboard in, e.g. of a `stackit_observability_grafana_folder`. If not set, the dashboard is stored in the general folder.",
+	"overwrite":   "Whether to overwrite an existing dashboard with the same UID or title when creating the dashboard. Defaults to `false`.",
+	"uid":         "The unique identifier of the dashboard, taken from `config_json` or generated by Grafana.",
+	"url":         "The URL path of the dashboard in the Grafana.",
+	"version":     "The version of the dashboard, incremented by Grafana on every save.",
+}
+
+// NewGrafanaDashboardResource is a helper function to simplify the provider implementation.
+func NewGrafanaDashboardResource() resource.Resource {
+	return &grafanaDashboardResource{}
+}
+
+// grafanaDashboardResource is the resource implementation.
+type grafanaDashboardResource struct {
+	client          *observability.APIClient
+	providerVersion string
+}
+
+// Metadata returns the resource type name.
+func (r *grafanaDashboardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_grafana_dashboard"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *grafanaDashboardResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	r.providerVersion = providerData.Version
+	tflog.Info(ctx, "Observability Grafana dashboard client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *grafanaDashboardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: descriptions["password"],
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"config_json": schema.StringAttribute{
+				Description: descriptions["config_json"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(uidChanged, "Changing the `uid` in `config_json` replaces the dashboard.", "Changing the `uid` in `config_json` replaces the dashboard."),
+				},
+			},
+			"folder_uid": schema.StringAttribute{
+				Description: descriptions["folder_uid"],
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(observabilityUtils.GrafanaUidRegex), "must contain at most 40 alphanumeric characters, dashes or underscores"),
+				},
+			},
+			"overwrite": schema.BoolAttribute{
+				Description: descriptions["overwrite"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"uid": schema.StringAttribute{
+				Description: descriptions["uid"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Computed:    true,
+			},
+			"version": schema.Int64Attribute{
+				Description: descriptions["version"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that `config_json` is a valid dashboard model.
+func (r *grafanaDashboardResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(model.ConfigJSON) {
+		return
+	}
+
+	dashboard, err := parseDashboard(model.ConfigJSON.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring Grafana dashboard", fmt.Sprintf("Invalid `config_json`: %v", err))
+		return
+	}
+	if title, ok := dashboard["title"].(string); !ok || strings.TrimSpace(title) == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring Grafana dashboard", "`config_json` must contain a non-empty `title`.")
+	}
+	if uid, ok := dashboard["uid"]; ok && uid != nil {
+		uidString, isString := uid.(string)
+		if !isString || !regexp.MustCompile(observabilityUtils.GrafanaUidRegex).MatchString(uidString) {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring Grafana dashboard", "The `uid` in `config_json` must contain at most 40 alphanumeric characters, dashes or underscores.")
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *grafanaDashboardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	payload, err := toPayload(&model, "")
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana dashboard", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana dashboard", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	saved, err := grafanaClient.SaveDashboard(ctx, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana dashboard", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	ctx = tflog.SetField(ctx, "dashboard_uid", saved.Uid)
+
+	err = mapSaveResponse(saved, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana dashboard", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Grafana dashboard created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *grafanaDashboardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	uid := model.Uid.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "dashboard_uid", uid)
+
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Grafana dashboard", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	dashboard, err := grafanaClient.GetDashboard(ctx, uid)
+	if err != nil {
+		if observabilityUtils.IsGrafanaNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Grafana dashboard", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(dashboard, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Grafana dashboard", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *grafanaDashboardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	uid := model.Uid.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "dashboard_uid", uid)
+
+	payload, err := toPayload(&model, uid)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana dashboard", fmt.Sprintf("Creating API payload: %v", err))
+		return
+	}
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana dashboard", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	saved, err := grafanaClient.SaveDashboard(ctx, payload)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana dashboard", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapSaveResponse(saved, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana dashboard", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Grafana dashboard updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *grafanaDashboardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	uid := model.Uid.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "dashboard_uid", uid)
+
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Grafana dashboard", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	err = grafanaClient.DeleteDashboard(ctx, uid)
+	if err != nil && !observabilityUtils.IsGrafanaNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Grafana dashboard", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Grafana dashboard deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,instance_id,uid
+func (r *grafanaDashboardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing Grafana dashboard",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id],[uid]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite"), false)...)
+	tflog.Info(ctx, "Grafana dashboard state imported")
+}
+
+func (r *grafanaDashboardResource) grafanaClient(ctx context.Context, model *Model) (*observabilityUtils.GrafanaClient, error) {
+	return observabilityUtils.ConfigureGrafanaClient(ctx, r.client, model.ProjectId.ValueString(), model.InstanceId.ValueString(), model.Username.ValueString(), model.Password.ValueString(), r.providerVersion)
+}
+
+// uidChanged requires a replacement if the planned `config_json` sets a UID which differs from the UID of the dashboard.
+func uidChanged(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	if req.StateValue.IsNull() || utils.IsUndefined(req.PlanValue) {
+		return
+	}
+	var stateUid types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("uid"), &stateUid)...)
+	if resp.Diagnostics.HasError() || utils.IsUndefined(stateUid) {
+		return
+	}
+	dashboard, err := parseDashboard(req.PlanValue.ValueString())
+	if err != nil {
+		return
+	}
+	uid, _ := dashboard["uid"].(string)
+	resp.RequiresReplace = uid != "" && uid != stateUid.ValueString()
+}
+
+// parseDashboard parses the JSON model of a dashboard.
+func parseDashboard(configJSON string) (map[string]any, error) {
+	dashboard := map[string]any{}
+	if err := json.Unmarshal([]byte(configJSON), &dashboard); err != nil {
+		return nil, fmt.Errorf("parsing JSON object: %w", err)
+	}
+	return dashboard, nil
+}
+
+// normalizeDashboard returns a copy of the dashboard without the fields managed by Grafana.
+// The UID is only kept if keepUid is true, as Grafana generates one if it is not part of the configuration.
+func normalizeDashboard(dashboard map[string]any, keepUid bool) map[string]any {
+	normalized := make(map[string]any, len(dashboard))
+	for k, v := range dashboard {
+		normalized[k] = v
+	}
+	for _, field := range volatileFields {
+		delete(normalized, field)
+	}
+	if !keepUid {
+		delete(normalized, "uid")
+	}
+	return normalized
+}
+
+func toPayload(model *Model, uid string) (*observabilityUtils.GrafanaSaveDashboardPayload, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	dashboard, err := parseDashboard(model.ConfigJSON.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	dashboard = normalizeDashboard(dashboard, true)
+	if uid != "" {
+		dashboard["uid"] = uid
+	}
+
+	payload := &observabilityUtils.GrafanaSaveDashboardPayload{
+		Dashboard: dashboard,
+		FolderUid: model.FolderUid.ValueString(),
+		// Existing dashboards are always overwritten on update, Grafana would reject the payload without the current version otherwise
+		Overwrite: uid != "" || model.Overwrite.ValueBool(),
+	}
+	return payload, nil
+}
+
+func mapSaveResponse(saved *observabilityUtils.GrafanaSaveDashboardResponse, model *Model) error {
+	if saved == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	if saved.Uid == "" {
+		return fmt.Errorf("dashboard uid not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString(), saved.Uid)
+	model.Uid = types.StringValue(saved.Uid)
+	model.Url = types.StringValue(saved.Url)
+	model.Version = types.Int64Value(saved.Version)
+	return nil
+}
+
+// mapFields maps the dashboard read from Grafana to the model. `config_json` is only overwritten if the dashboard
+// differs from it in other than the fields managed by Grafana, to show the changes made outside of Terraform.
+func mapFields(dashboard *observabilityUtils.GrafanaDashboard, model *Model) error {
+	if dashboard == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	uid, _ := dashboard.Dashboard["uid"].(string)
+	if uid == "" {
+		uid = model.Uid.ValueString()
+	}
+	if uid == "" {
+		return fmt.Errorf("dashboard uid not present")
+	}
+
+	keepUid := true
+	if !model.ConfigJSON.IsNull() {
+		current, err := parseDashboard(model.ConfigJSON.ValueString())
+		if err != nil {
+			return fmt.Errorf("parsing config_json of the state: %w", err)
+		}
+		_, keepUid = current["uid"]
+		current = normalizeDashboard(current, keepUid)
+		remote := normalizeDashboard(dashboard.Dashboard, keepUid)
+		// Compare the JSON representations, so that numbers are compared the same way on both sides
+		currentJSON, err := json.Marshal(current)
+		if err != nil {
+			return fmt.Errorf("encoding config_json of the state: %w", err)
+		}
+		remoteJSON, err := json.Marshal(remote)
+		if err != nil {
+			return fmt.Errorf("encoding dashboard: %w", err)
+		}
+		if string(currentJSON) != string(remoteJSON) {
+			model.ConfigJSON = types.StringValue(string(remoteJSON))
+		}
+	} else {
+		// Imported dashboards
+		remoteJSON, err := json.Marshal(normalizeDashboard(dashboard.Dashboard, keepUid))
+		if err != nil {
+			return fmt.Errorf("encoding dashboard: %w", err)
+		}
+		model.ConfigJSON = types.StringValue(string(remoteJSON))
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString(), uid)
+	model.Uid = types.StringValue(uid)
+	model.Url = types.StringValue(dashboard.Meta.Url)
+	model.Version = types.Int64Value(dashboard.Meta.Version)
+	model.FolderUid = types.StringNull()
+	if dashboard.Meta.FolderUid != "" {
+		model.FolderUid = types.StringValue(dashboard.Meta.FolderUid)
+	}
+	return nil
+}
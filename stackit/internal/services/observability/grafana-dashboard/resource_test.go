@@ -0,0 +1,222 @@
+package grafanadashboard
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+)
+
+func TestMapFields(t *testing.T) {
+	state := func(configJSON types.String) Model {
+		return Model{
+			ProjectId:  types.StringValue("pid"),
+			InstanceId: types.StringValue("iid"),
+			ConfigJSON: configJSON,
+			FolderUid:  types.StringValue("team-a"),
+			Uid:        types.StringValue("nodes"),
+		}
+	}
+	expected := func(configJSON types.String, folderUid types.String) Model {
+		return Model{
+			Id:         types.StringValue("pid,iid,nodes"),
+			ProjectId:  types.StringValue("pid"),
+			InstanceId: types.StringValue("iid"),
+			ConfigJSON: configJSON,
+			FolderUid:  folderUid,
+			Uid:        types.StringValue("nodes"),
+			Url:        types.StringValue("/d/nodes/nodes"),
+			Version:    types.Int64Value(3),
+		}
+	}
+	remote := func(dashboard map[string]any, folderUid string) *observabilityUtils.GrafanaDashboard {
+		return &observabilityUtils.GrafanaDashboard{
+			Dashboard: dashboard,
+			Meta: observabilityUtils.GrafanaDashboardMeta{
+				FolderUid: folderUid,
+				Url:       "/d/nodes/nodes",
+				Version:   3,
+			},
+		}
+	}
+	configJSON := types.StringValue(`{
+  "title": "Nodes",
+  "panels": [{"type": "graph", "gridPos": {"h": 8, "w": 12}}]
+}`)
+
+	tests := []struct {
+		description string
+		input       *observabilityUtils.GrafanaDashboard
+		state       Model
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"volatile_fields_ignored",
+			remote(map[string]any{
+				"id":      float64(12),
+				"uid":     "nodes",
+				"version": float64(3),
+				"title":   "Nodes",
+				"panels":  []any{map[string]any{"type": "graph", "gridPos": map[string]any{"h": float64(8), "w": float64(12)}}},
+			}, "team-a"),
+			state(configJSON),
+			expected(configJSON, types.StringValue("team-a")),
+			true,
+		},
+		{
+			"uid_in_config_kept",
+			remote(map[string]any{
+				"id":      float64(12),
+				"uid":     "nodes",
+				"version": float64(3),
+				"title":   "Nodes",
+			}, "team-a"),
+			state(types.StringValue(`{"uid": "nodes", "title": "Nodes", "version": 1}`)),
+			expected(types.StringValue(`{"uid": "nodes", "title": "Nodes", "version": 1}`), types.StringValue("team-a")),
+			true,
+		},
+		{
+			"drift",
+			remote(map[string]any{
+				"id":      float64(12),
+				"uid":     "nodes",
+				"version": float64(3),
+				"title":   "Nodes (edited)",
+			}, ""),
+			state(configJSON),
+			expected(types.StringValue(`{"title":"Nodes (edited)"}`), types.StringNull()),
+			true,
+		},
+		{
+			"imported",
+			remote(map[string]any{
+				"id":      float64(12),
+				"uid":     "nodes",
+				"version": float64(3),
+				"title":   "Nodes",
+			}, "team-a"),
+			state(types.StringNull()),
+			expected(types.StringValue(`{"title":"Nodes","uid":"nodes"}`), types.StringValue("team-a")),
+			true,
+		},
+		{
+			"invalid_state_json",
+			remote(map[string]any{"uid": "nodes"}, ""),
+			state(types.StringValue("{")),
+			Model{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			state(configJSON),
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		uid         string
+		expected    *observabilityUtils.GrafanaSaveDashboardPayload
+		isValid     bool
+	}{
+		{
+			"create",
+			&Model{
+				ConfigJSON: types.StringValue(`{"id": 3, "version": 7, "title": "Nodes"}`),
+				FolderUid:  types.StringNull(),
+				Overwrite:  types.BoolValue(false),
+			},
+			"",
+			&observabilityUtils.GrafanaSaveDashboardPayload{
+				Dashboard: map[string]any{"title": "Nodes"},
+			},
+			true,
+		},
+		{
+			"create_overwrite_in_folder",
+			&Model{
+				ConfigJSON: types.StringValue(`{"uid": "nodes", "title": "Nodes"}`),
+				FolderUid:  types.StringValue("team-a"),
+				Overwrite:  types.BoolValue(true),
+			},
+			"",
+			&observabilityUtils.GrafanaSaveDashboardPayload{
+				Dashboard: map[string]any{"uid": "nodes", "title": "Nodes"},
+				FolderUid: "team-a",
+				Overwrite: true,
+			},
+			true,
+		},
+		{
+			"update",
+			&Model{
+				ConfigJSON: types.StringValue(`{"title": "Nodes"}`),
+				FolderUid:  types.StringNull(),
+				Overwrite:  types.BoolValue(false),
+			},
+			"generated",
+			&observabilityUtils.GrafanaSaveDashboardPayload{
+				Dashboard: map[string]any{"uid": "generated", "title": "Nodes"},
+				Overwrite: true,
+			},
+			true,
+		},
+		{
+			"invalid_json",
+			&Model{
+				ConfigJSON: types.StringValue(`[]`),
+			},
+			"",
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			"",
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toPayload(tt.input, tt.uid)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package grafanafolder
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &grafanaFolderResource{}
+	_ resource.ResourceWithConfigure   = &grafanaFolderResource{}
+	_ resource.ResourceWithImportState = &grafanaFolderResource{}
+)
+
+type Model struct {
+	Id         types.String `tfsdk:"id"` // needed by TF
+	ProjectId  types.String `tfsdk:"project_id"`
+	InstanceId types.String `tfsdk:"instance_id"`
+	Username   types.String `tfsdk:"username"`
+	Password   types.String `tfsdk:"password"`
+	Uid        types.String `tfsdk:"uid"`
+	Title      types.String `tfsdk:"title"`
+	Url        types.String `tfsdk:"url"`
+}
+
+// Descriptions for the resource schema are centralized here.
+var descriptions = map[string]string{
+	"main":        "Observability Grafana folder resource schema. Manages a folder in the Grafana of an observability instance through the Grafana HTTP API. Must have a `region` specified in the provider configuration.",
+	"id":          "Terraform's internal resource ID. It is structured as \"`project_id`,`instance_id`,`uid`\".",
+	"project_id":  "STACKIT project ID to which the observability instance is associated.",
+	"instance_id": "Observability instance ID of the Grafana.",
+	"username":    "Username to authenticate against the Grafana, e.g. of a `stackit_observability_credential`. If not set, the initial Grafana admin credentials of the instance are used.",
+	"password":    "Password to authenticate against the Grafana. Must be set together with `username`.",
+	"uid":         "The unique identifier of the folder. Generated by Grafana if not set.",
+	"title":       "The title of the folder.",
+	"url":         "The URL path of the folder in the Grafana.",
+}
+
+// NewGrafanaFolderResource is a helper function to simplify the provider implementation.
+func NewGrafanaFolderResource() resource.Resource {
+	return &grafanaFolderResource{}
+}
+
+// grafanaFolderResource is the resource implementation.
+type grafanaFolderResource struct {
+	client          *observability.APIClient
+	providerVersion string
+}
+
+// Metadata returns the resource type name.
+func (r *grafanaFolderResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_grafana_folder"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *grafanaFolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	r.providerVersion = providerData.Version
+	tflog.Info(ctx, "Observability Grafana folder client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *grafanaFolderResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"instance_id": schema.StringAttribute{
+				Description: descriptions["instance_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Description: descriptions["username"],
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("password")),
+				},
+			},
+			"password": schema.StringAttribute{
+				Description: descriptions["password"],
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"uid": schema.StringAttribute{
+				Description: descriptions["uid"],
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					validate.NoSeparator(),
+					stringvalidator.RegexMatches(regexp.MustCompile(observabilityUtils.GrafanaUidRegex), "must contain at most 40 alphanumeric characters, dashes or underscores"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Description: descriptions["title"],
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"url": schema.StringAttribute{
+				Description: descriptions["url"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *grafanaFolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana folder", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	folder, err := grafanaClient.CreateFolder(ctx, toPayload(&model))
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana folder", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	ctx = tflog.SetField(ctx, "folder_uid", folder.Uid)
+
+	err = mapFields(folder, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating Grafana folder", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Grafana folder created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *grafanaFolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	uid := model.Uid.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "folder_uid", uid)
+
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Grafana folder", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	folder, err := grafanaClient.GetFolder(ctx, uid)
+	if err != nil {
+		if observabilityUtils.IsGrafanaNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Grafana folder", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(folder, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Grafana folder", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *grafanaFolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from plan
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	uid := model.Uid.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "folder_uid", uid)
+
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana folder", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	folder, err := grafanaClient.UpdateFolder(ctx, uid, model.Title.ValueString())
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana folder", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapFields(folder, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating Grafana folder", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Grafana folder updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *grafanaFolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	// Retrieve values from state
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	instanceId := model.InstanceId.ValueString()
+	uid := model.Uid.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+	ctx = tflog.SetField(ctx, "folder_uid", uid)
+
+	grafanaClient, err := r.grafanaClient(ctx, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Grafana folder", fmt.Sprintf("Configuring Grafana client: %v", err))
+		return
+	}
+
+	err = grafanaClient.DeleteFolder(ctx, uid)
+	if err != nil && !observabilityUtils.IsGrafanaNotFound(err) {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting Grafana folder", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, "Grafana folder deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,instance_id,uid
+func (r *grafanaFolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing Grafana folder",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id],[uid]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid"), idParts[2])...)
+	tflog.Info(ctx, "Grafana folder state imported")
+}
+
+func (r *grafanaFolderResource) grafanaClient(ctx context.Context, model *Model) (*observabilityUtils.GrafanaClient, error) {
+	return observabilityUtils.ConfigureGrafanaClient(ctx, r.client, model.ProjectId.ValueString(), model.InstanceId.ValueString(), model.Username.ValueString(), model.Password.ValueString(), r.providerVersion)
+}
+
+func toPayload(model *Model) *observabilityUtils.GrafanaFolder {
+	folder := &observabilityUtils.GrafanaFolder{
+		Title: model.Title.ValueString(),
+	}
+	if !utils.IsUndefined(model.Uid) {
+		folder.Uid = model.Uid.ValueString()
+	}
+	return folder
+}
+
+func mapFields(folder *observabilityUtils.GrafanaFolder, model *Model) error {
+	if folder == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var uid string
+	if folder.Uid != "" {
+		uid = folder.Uid
+	} else if !utils.IsUndefined(model.Uid) {
+		uid = model.Uid.ValueString()
+	} else {
+		return fmt.Errorf("folder uid not present")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), model.InstanceId.ValueString(), uid)
+	model.Uid = types.StringValue(uid)
+	model.Title = types.StringValue(folder.Title)
+	model.Url = types.StringValue(folder.Url)
+	return nil
+}
@@ -0,0 +1,138 @@
+package grafanafolder
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observabilityUtils.GrafanaFolder
+		state       Model
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&observabilityUtils.GrafanaFolder{
+				Id:    1,
+				Uid:   "team-a",
+				Title: "Team A",
+				Url:   "/dashboards/f/team-a/team-a",
+			},
+			Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Uid:        types.StringUnknown(),
+			},
+			Model{
+				Id:         types.StringValue("pid,iid,team-a"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Uid:        types.StringValue("team-a"),
+				Title:      types.StringValue("Team A"),
+				Url:        types.StringValue("/dashboards/f/team-a/team-a"),
+			},
+			true,
+		},
+		{
+			"uid_from_state",
+			&observabilityUtils.GrafanaFolder{
+				Title: "Team A",
+			},
+			Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Uid:        types.StringValue("team-a"),
+			},
+			Model{
+				Id:         types.StringValue("pid,iid,team-a"),
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+				Uid:        types.StringValue("team-a"),
+				Title:      types.StringValue("Team A"),
+				Url:        types.StringValue(""),
+			},
+			true,
+		},
+		{
+			"no_uid",
+			&observabilityUtils.GrafanaFolder{
+				Title: "Team A",
+			},
+			Model{
+				ProjectId:  types.StringValue("pid"),
+				InstanceId: types.StringValue("iid"),
+			},
+			Model{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := mapFields(tt.input, &tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(tt.state, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToPayload(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *Model
+		expected    *observabilityUtils.GrafanaFolder
+	}{
+		{
+			"generated_uid",
+			&Model{
+				Title: types.StringValue("Team A"),
+				Uid:   types.StringUnknown(),
+			},
+			&observabilityUtils.GrafanaFolder{
+				Title: "Team A",
+			},
+		},
+		{
+			"configured_uid",
+			&Model{
+				Title: types.StringValue("Team A"),
+				Uid:   types.StringValue("team-a"),
+			},
+			&observabilityUtils.GrafanaFolder{
+				Uid:   "team-a",
+				Title: "Team A",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := toPayload(tt.input)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
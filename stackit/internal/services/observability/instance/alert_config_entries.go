@@ -0,0 +1,251 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// alertConfigEntries holds the receivers and child routes of an alert config which are managed by the instance resource.
+// Child routes are identified by their receiver, like in the alert config route endpoints.
+// All other receivers and child routes are managed externally, e.g. by the stackit_observability_alert_receiver
+// and stackit_observability_alert_route resources, and are ignored by the instance resource.
+type alertConfigEntries struct {
+	receivers   map[string]bool
+	childRoutes map[string]bool
+}
+
+// getAlertConfigEntries returns the receivers and child routes of the given alert config.
+// If the alert config is null or unknown, the entries of the mock alert config are returned.
+func getAlertConfigEntries(ctx context.Context, alertConfig types.Object) (*alertConfigEntries, error) {
+	alertConfigTF := alertConfigModel{}
+	if alertConfig.IsNull() || alertConfig.IsUnknown() {
+		var err error
+		alertConfigTF, err = getMockAlertConfig(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("getting mock alert config: %w", err)
+		}
+	} else {
+		diags := alertConfig.As(ctx, &alertConfigTF, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping alert config: %w", core.DiagsToError(diags))
+		}
+	}
+
+	entries := &alertConfigEntries{
+		receivers:   map[string]bool{},
+		childRoutes: map[string]bool{},
+	}
+
+	if !alertConfigTF.Receivers.IsNull() && !alertConfigTF.Receivers.IsUnknown() {
+		receivers := []receiversModel{}
+		diags := alertConfigTF.Receivers.ElementsAs(ctx, &receivers, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping receivers: %w", core.DiagsToError(diags))
+		}
+		for i := range receivers {
+			entries.receivers[receivers[i].Name.ValueString()] = true
+		}
+	}
+
+	if !alertConfigTF.Route.IsNull() && !alertConfigTF.Route.IsUnknown() {
+		routeTF := routeModel{}
+		diags := alertConfigTF.Route.As(ctx, &routeTF, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping route: %w", core.DiagsToError(diags))
+		}
+		for _, elem := range routeTF.Routes.Elements() {
+			childRoute, ok := elem.(types.Object)
+			if !ok {
+				return nil, fmt.Errorf("child route has unexpected type %T", elem)
+			}
+			receiver, ok := childRoute.Attributes()["receiver"].(types.String)
+			if !ok {
+				return nil, fmt.Errorf("child route has no receiver")
+			}
+			entries.childRoutes[receiver.ValueString()] = true
+		}
+	}
+
+	return entries, nil
+}
+
+// add adds the receivers and child routes of other to the entries
+func (e *alertConfigEntries) add(other *alertConfigEntries) {
+	for name := range other.receivers {
+		e.receivers[name] = true
+	}
+	for receiver := range other.childRoutes {
+		e.childRoutes[receiver] = true
+	}
+}
+
+// filterAlertConfigEntries returns a copy of the alert config response which only contains the given receivers and child routes
+func filterAlertConfigEntries(resp *observability.GetAlertConfigsResponse, entries *alertConfigEntries) *observability.GetAlertConfigsResponse {
+	if resp == nil || resp.Data == nil {
+		return resp
+	}
+
+	data := *resp.Data
+	if data.Receivers != nil {
+		receivers := []observability.Receivers{}
+		for _, receiver := range *data.Receivers {
+			if receiver.Name != nil && entries.receivers[*receiver.Name] {
+				receivers = append(receivers, receiver)
+			}
+		}
+		data.Receivers = &receivers
+	}
+	if data.Route != nil && data.Route.Routes != nil {
+		route := *data.Route
+		childRoutes := []observability.RouteSerializer{}
+		for _, childRoute := range *route.Routes {
+			if childRoute.Receiver != nil && entries.childRoutes[*childRoute.Receiver] {
+				childRoutes = append(childRoutes, childRoute)
+			}
+		}
+		if len(childRoutes) == 0 {
+			route.Routes = nil
+		} else {
+			route.Routes = &childRoutes
+		}
+		data.Route = &route
+	}
+
+	return &observability.GetAlertConfigsResponse{
+		Data:    &data,
+		Message: resp.Message,
+	}
+}
+
+// mapManagedAlertConfigField maps the alert config response to the model, ignoring the externally managed receivers and child routes.
+// If the alert config in the model is null, e.g. after an import, and the managed part of the alert config doesn't match
+// the mock alert config, the whole alert config is mapped.
+func mapManagedAlertConfigField(ctx context.Context, resp *observability.GetAlertConfigsResponse, model *Model) error {
+	if model == nil {
+		return fmt.Errorf("nil model")
+	}
+
+	entries, err := getAlertConfigEntries(ctx, model.AlertConfig)
+	if err != nil {
+		return fmt.Errorf("getting managed alert config entries: %w", err)
+	}
+	alertConfigUnset := model.AlertConfig.IsNull() || model.AlertConfig.IsUnknown()
+
+	err = mapAlertConfigField(ctx, filterAlertConfigEntries(resp, entries), model)
+	if err != nil {
+		return err
+	}
+	if alertConfigUnset && !model.AlertConfig.IsNull() {
+		return mapAlertConfigField(ctx, resp, model)
+	}
+	return nil
+}
+
+// addExternalAlertConfigEntries adds the receivers and child routes of the current alert config which are not
+// managed by the instance resource to the payload, so they are not removed by the update.
+func addExternalAlertConfigEntries(payload *observability.UpdateAlertConfigsPayload, resp *observability.GetAlertConfigsResponse, managed *alertConfigEntries) {
+	if payload == nil || resp == nil || resp.Data == nil {
+		return
+	}
+
+	if resp.Data.Receivers != nil {
+		for _, receiver := range *resp.Data.Receivers {
+			if receiver.Name == nil || managed.receivers[*receiver.Name] {
+				continue
+			}
+			if payload.Receivers == nil {
+				payload.Receivers = &[]observability.UpdateAlertConfigsPayloadReceiversInner{}
+			}
+			*payload.Receivers = append(*payload.Receivers, toExternalReceiverPayload(&receiver))
+		}
+	}
+
+	if payload.Route != nil && resp.Data.Route != nil && resp.Data.Route.Routes != nil {
+		for _, childRoute := range *resp.Data.Route.Routes {
+			if childRoute.Receiver == nil || managed.childRoutes[*childRoute.Receiver] {
+				continue
+			}
+			if payload.Route.Routes == nil {
+				payload.Route.Routes = &[]observability.CreateAlertConfigRoutePayloadRoutesInner{}
+			}
+			*payload.Route.Routes = append(*payload.Route.Routes, toExternalChildRoutePayload(&childRoute))
+		}
+	}
+}
+
+func toExternalReceiverPayload(receiver *observability.Receivers) observability.UpdateAlertConfigsPayloadReceiversInner {
+	receiverPayload := observability.UpdateAlertConfigsPayloadReceiversInner{
+		Name: receiver.Name,
+	}
+	if receiver.EmailConfigs != nil {
+		emailConfigs := []observability.CreateAlertConfigReceiverPayloadEmailConfigsInner{}
+		for _, emailConfig := range *receiver.EmailConfigs {
+			emailConfigs = append(emailConfigs, observability.CreateAlertConfigReceiverPayloadEmailConfigsInner{
+				AuthIdentity: emailConfig.AuthIdentity,
+				AuthPassword: emailConfig.AuthPassword,
+				AuthUsername: emailConfig.AuthUsername,
+				From:         emailConfig.From,
+				SendResolved: emailConfig.SendResolved,
+				Smarthost:    emailConfig.Smarthost,
+				To:           emailConfig.To,
+			})
+		}
+		receiverPayload.EmailConfigs = &emailConfigs
+	}
+	if receiver.OpsgenieConfigs != nil {
+		opsgenieConfigs := []observability.CreateAlertConfigReceiverPayloadOpsgenieConfigsInner{}
+		for _, opsgenieConfig := range *receiver.OpsgenieConfigs {
+			opsgenieConfigs = append(opsgenieConfigs, observability.CreateAlertConfigReceiverPayloadOpsgenieConfigsInner{
+				ApiKey:       opsgenieConfig.ApiKey,
+				ApiUrl:       opsgenieConfig.ApiUrl,
+				Priority:     opsgenieConfig.Priority,
+				SendResolved: opsgenieConfig.SendResolved,
+				Tags:         opsgenieConfig.Tags,
+			})
+		}
+		receiverPayload.OpsgenieConfigs = &opsgenieConfigs
+	}
+	if receiver.WebHookConfigs != nil {
+		webHookConfigs := []observability.CreateAlertConfigReceiverPayloadWebHookConfigsInner{}
+		for _, webHookConfig := range *receiver.WebHookConfigs {
+			webHookConfigs = append(webHookConfigs, observability.CreateAlertConfigReceiverPayloadWebHookConfigsInner{
+				MsTeams:      webHookConfig.MsTeams,
+				SendResolved: webHookConfig.SendResolved,
+				Url:          webHookConfig.Url,
+			})
+		}
+		receiverPayload.WebHookConfigs = &webHookConfigs
+	}
+	return receiverPayload
+}
+
+func toExternalChildRoutePayload(childRoute *observability.RouteSerializer) observability.CreateAlertConfigRoutePayloadRoutesInner {
+	return observability.CreateAlertConfigRoutePayloadRoutesInner{
+		Continue:       childRoute.Continue,
+		GroupBy:        childRoute.GroupBy,
+		GroupInterval:  childRoute.GroupInterval,
+		GroupWait:      childRoute.GroupWait,
+		Match:          toInterfaceMap(childRoute.Match),
+		MatchRe:        toInterfaceMap(childRoute.MatchRe),
+		Matchers:       childRoute.Matchers,
+		Receiver:       childRoute.Receiver,
+		RepeatInterval: childRoute.RepeatInterval,
+		// Routes not currently supported
+	}
+}
+
+func toInterfaceMap(in *map[string]string) *map[string]interface{} {
+	if in == nil {
+		return nil
+	}
+	out := map[string]interface{}{}
+	for k, v := range *in {
+		out[k] = v
+	}
+	return &out
+}
@@ -0,0 +1,325 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func fixtureExternalReceiverResponse() observability.Receivers {
+	return observability.Receivers{
+		Name: utils.Ptr("team-a"),
+		WebHookConfigs: &[]observability.WebHook{
+			{
+				Url:     utils.Ptr("http://team-a.example.com"),
+				MsTeams: utils.Ptr(false),
+			},
+		},
+	}
+}
+
+func fixtureExternalChildRouteResponse() observability.RouteSerializer {
+	return observability.RouteSerializer{
+		Receiver: utils.Ptr("team-a"),
+		Match:    &map[string]string{"team": "a"},
+		Continue: utils.Ptr(true),
+	}
+}
+
+func fixtureMockAlertConfigResponse() *observability.GetAlertConfigsResponse {
+	return &observability.GetAlertConfigsResponse{
+		Data: &observability.Alert{
+			Receivers: &[]observability.Receivers{
+				{
+					Name: utils.Ptr("email-me"),
+					EmailConfigs: &[]observability.EmailConfig{
+						{
+							To:           utils.Ptr("123@gmail.com"),
+							Smarthost:    utils.Ptr("smtp.gmail.com:587"),
+							From:         utils.Ptr("xxxx@gmail.com"),
+							AuthUsername: utils.Ptr("xxxx@gmail.com"),
+							AuthPassword: utils.Ptr("xxxxxxxxx"),
+							AuthIdentity: utils.Ptr("xxxx@gmail.com"),
+						},
+					},
+				},
+				fixtureExternalReceiverResponse(),
+			},
+			Route: &observability.Route{
+				Receiver:       utils.Ptr("email-me"),
+				GroupBy:        utils.Ptr([]string{"job"}),
+				GroupWait:      utils.Ptr("30s"),
+				GroupInterval:  utils.Ptr("5m"),
+				RepeatInterval: utils.Ptr("4h"),
+				Routes: &[]observability.RouteSerializer{
+					fixtureExternalChildRouteResponse(),
+				},
+			},
+			Global: &observability.Global{
+				ResolveTimeout: utils.Ptr("5m"),
+				SmtpFrom:       utils.Ptr("observability@observability.stackit.cloud"),
+			},
+		},
+	}
+}
+
+func fixtureAlertConfigResponseWithExternalEntries() *observability.GetAlertConfigsResponse {
+	route := fixtureRouteResponse()
+	*route.Routes = append(*route.Routes, fixtureExternalChildRouteResponse())
+	return &observability.GetAlertConfigsResponse{
+		Data: &observability.Alert{
+			Receivers: &[]observability.Receivers{
+				fixtureReceiverResponse(
+					&[]observability.EmailConfig{
+						fixtureEmailConfigsResponse(),
+					},
+					nil,
+					nil,
+				),
+				fixtureExternalReceiverResponse(),
+			},
+			Route: route,
+		},
+	}
+}
+
+func TestMapManagedAlertConfigField(t *testing.T) {
+	managedAlertConfig := types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
+		"receivers": types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{
+			fixtureReceiverModel(
+				fixtureEmailConfigsModel(),
+				types.ListNull(types.ObjectType{AttrTypes: opsgenieConfigsTypes}),
+				types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
+			),
+		}),
+		"route":         fixtureRouteModel(),
+		"global":        types.ObjectNull(globalConfigurationTypes),
+		"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
+	})
+	externalReceiverModel := types.ObjectValueMust(receiversTypes, map[string]attr.Value{
+		"name":             types.StringValue("team-a"),
+		"email_configs":    types.ListNull(types.ObjectType{AttrTypes: emailConfigsTypes}),
+		"opsgenie_configs": types.ListNull(types.ObjectType{AttrTypes: opsgenieConfigsTypes}),
+		"webhooks_configs": types.ListValueMust(types.ObjectType{AttrTypes: webHooksConfigsTypes}, []attr.Value{
+			types.ObjectValueMust(webHooksConfigsTypes, map[string]attr.Value{
+				"url":      types.StringValue("http://team-a.example.com"),
+				"ms_teams": types.BoolValue(false),
+			}),
+		}),
+	})
+	routeWithExternalChildRoute := fixtureRouteModel().Attributes()
+	routeWithExternalChildRoute["routes"] = types.ListValueMust(getRouteListType(), append(
+		fixtureRouteModel().Attributes()["routes"].(types.List).Elements(), //nolint:forcetypeassert // test fixture
+		types.ObjectValueMust(getRouteListType().AttrTypes, map[string]attr.Value{
+			"group_by":        types.ListNull(types.StringType),
+			"group_interval":  types.StringNull(),
+			"group_wait":      types.StringNull(),
+			"match":           types.MapValueMust(types.StringType, map[string]attr.Value{"team": types.StringValue("a")}),
+			"match_regex":     types.MapNull(types.StringType),
+			"receiver":        types.StringValue("team-a"),
+			"repeat_interval": types.StringNull(),
+		}),
+	))
+
+	tests := []struct {
+		description     string
+		alertConfig     types.Object
+		alertConfigResp *observability.GetAlertConfigsResponse
+		expected        types.Object
+		isValid         bool
+	}{
+		{
+			description:     "unset_with_external_entries",
+			alertConfig:     types.ObjectNull(alertConfigTypes),
+			alertConfigResp: fixtureMockAlertConfigResponse(),
+			expected:        types.ObjectNull(alertConfigTypes),
+			isValid:         true,
+		},
+		{
+			description:     "unset_with_custom_config",
+			alertConfig:     types.ObjectNull(alertConfigTypes),
+			alertConfigResp: fixtureAlertConfigResponseWithExternalEntries(),
+			expected: types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
+				"receivers": types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{
+					fixtureReceiverModel(
+						fixtureEmailConfigsModel(),
+						types.ListNull(types.ObjectType{AttrTypes: opsgenieConfigsTypes}),
+						types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
+					),
+					externalReceiverModel,
+				}),
+				"route":         types.ObjectValueMust(routeTypes, routeWithExternalChildRoute),
+				"global":        types.ObjectNull(globalConfigurationTypes),
+				"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
+			}),
+			isValid: true,
+		},
+		{
+			description:     "set_with_external_entries",
+			alertConfig:     managedAlertConfig,
+			alertConfigResp: fixtureAlertConfigResponseWithExternalEntries(),
+			expected:        managedAlertConfig,
+			isValid:         true,
+		},
+		{
+			description: "managed_entries_removed",
+			alertConfig: managedAlertConfig,
+			alertConfigResp: &observability.GetAlertConfigsResponse{
+				Data: &observability.Alert{
+					Receivers: &[]observability.Receivers{
+						fixtureExternalReceiverResponse(),
+					},
+					Route: &observability.Route{},
+				},
+			},
+			expected: types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
+				"receivers":     types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{}),
+				"route":         fixtureNullRouteModel(),
+				"global":        types.ObjectNull(globalConfigurationTypes),
+				"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
+			}),
+			isValid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				AlertConfig: tt.alertConfig,
+			}
+			err := mapManagedAlertConfigField(context.Background(), tt.alertConfigResp, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model.AlertConfig, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAddExternalAlertConfigEntries(t *testing.T) {
+	tests := []struct {
+		description string
+		payload     *observability.UpdateAlertConfigsPayload
+		currentResp *observability.GetAlertConfigsResponse
+		managed     *alertConfigEntries
+		expected    *observability.UpdateAlertConfigsPayload
+	}{
+		{
+			description: "external_entries_kept",
+			payload: &observability.UpdateAlertConfigsPayload{
+				Receivers: &[]observability.UpdateAlertConfigsPayloadReceiversInner{
+					fixtureReceiverPayload(nil, nil, nil),
+				},
+				Route: &observability.UpdateAlertConfigsPayloadRoute{
+					Receiver: utils.Ptr("name"),
+				},
+			},
+			currentResp: &observability.GetAlertConfigsResponse{
+				Data: &observability.Alert{
+					Receivers: &[]observability.Receivers{
+						fixtureReceiverResponse(nil, nil, nil),
+						{Name: utils.Ptr("removed")},
+						fixtureExternalReceiverResponse(),
+					},
+					Route: &observability.Route{
+						Receiver: utils.Ptr("name"),
+						Routes: &[]observability.RouteSerializer{
+							{Receiver: utils.Ptr("removed")},
+							fixtureExternalChildRouteResponse(),
+						},
+					},
+				},
+			},
+			managed: &alertConfigEntries{
+				receivers:   map[string]bool{"name": true, "removed": true},
+				childRoutes: map[string]bool{"removed": true},
+			},
+			expected: &observability.UpdateAlertConfigsPayload{
+				Receivers: &[]observability.UpdateAlertConfigsPayloadReceiversInner{
+					fixtureReceiverPayload(nil, nil, nil),
+					{
+						Name: utils.Ptr("team-a"),
+						WebHookConfigs: &[]observability.CreateAlertConfigReceiverPayloadWebHookConfigsInner{
+							{
+								Url:     utils.Ptr("http://team-a.example.com"),
+								MsTeams: utils.Ptr(false),
+							},
+						},
+					},
+				},
+				Route: &observability.UpdateAlertConfigsPayloadRoute{
+					Receiver: utils.Ptr("name"),
+					Routes: &[]observability.CreateAlertConfigRoutePayloadRoutesInner{
+						{
+							Receiver: utils.Ptr("team-a"),
+							Match:    &map[string]interface{}{"team": "a"},
+							Continue: utils.Ptr(true),
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "no_external_entries",
+			payload: &observability.UpdateAlertConfigsPayload{
+				Receivers: &[]observability.UpdateAlertConfigsPayloadReceiversInner{
+					fixtureReceiverPayload(nil, nil, nil),
+				},
+				Route: fixtureRoutePayload(),
+			},
+			currentResp: &observability.GetAlertConfigsResponse{
+				Data: &observability.Alert{
+					Receivers: &[]observability.Receivers{
+						fixtureReceiverResponse(nil, nil, nil),
+					},
+					Route: fixtureRouteResponse(),
+				},
+			},
+			managed: &alertConfigEntries{
+				receivers:   map[string]bool{"name": true},
+				childRoutes: map[string]bool{"name": true},
+			},
+			expected: &observability.UpdateAlertConfigsPayload{
+				Receivers: &[]observability.UpdateAlertConfigsPayloadReceiversInner{
+					fixtureReceiverPayload(nil, nil, nil),
+				},
+				Route: fixtureRoutePayload(),
+			},
+		},
+		{
+			description: "nil_current_config",
+			payload: &observability.UpdateAlertConfigsPayload{
+				Route: fixtureRoutePayload(),
+			},
+			currentResp: nil,
+			managed: &alertConfigEntries{
+				receivers:   map[string]bool{},
+				childRoutes: map[string]bool{},
+			},
+			expected: &observability.UpdateAlertConfigsPayload{
+				Route: fixtureRoutePayload(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			addExternalAlertConfigEntries(tt.payload, tt.currentResp, tt.managed)
+			diff := cmp.Diff(tt.payload, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
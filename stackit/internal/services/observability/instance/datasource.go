@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability/wait"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
@@ -180,6 +181,14 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 			"zipkin_spans_url": schema.StringAttribute{
 				Computed: true,
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the instance, e.g. `CREATE_SUCCEEDED` or `CREATING`.",
+				Computed:    true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "If set to `true`, reading the data source waits until the instance is ready. Can be used to wait for an instance created with `wait_for_ready = false`. Defaults to `false`.",
+				Optional:    true,
+			},
 			"acl": schema.SetAttribute{
 				Description: "The access control list for this instance. Each entry is an IP address range that is permitted to access, in CIDR notation.",
 				ElementType: types.StringType,
@@ -210,6 +219,7 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 											"auth_password": schema.StringAttribute{
 												Description: "SMTP authentication password.",
 												Computed:    true,
+												Sensitive:   true,
 											},
 											"auth_username": schema.StringAttribute{
 												Description: "SMTP authentication username.",
@@ -238,6 +248,7 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 											"api_key": schema.StringAttribute{
 												Description: "The API key for OpsGenie.",
 												Computed:    true,
+												Sensitive:   true,
 											},
 											"api_url": schema.StringAttribute{
 												Description: "The host to send OpsGenie API requests to. Must be a valid URL",
@@ -307,6 +318,39 @@ func (d *instanceDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 							"routes": getDatasourceRouteNestedObject(),
 						},
 					},
+					"inhibit_rules": schema.ListNestedAttribute{
+						Description: inhibitRulesDescriptions["main"],
+						Computed:    true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"equal": schema.ListAttribute{
+									Description: inhibitRulesDescriptions["equal"],
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"source_match": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["source_match"],
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"source_match_regex": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["source_match_regex"],
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"target_match": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["target_match"],
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"target_match_regex": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["target_match_regex"],
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
 					"global": schema.SingleNestedAttribute{
 						Description: "Global configuration for the alerts.",
 						Computed:    true,
@@ -363,7 +407,15 @@ func (d *instanceDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 	projectId := model.ProjectId.ValueString()
 	instanceId := model.InstanceId.ValueString()
-	instanceResp, err := d.client.GetInstance(ctx, instanceId, projectId).Execute()
+	var instanceResp *observability.GetInstanceResponse
+	var err error
+	if model.WaitForReady.ValueBool() {
+		waitCtx := core.InitWaitProgress(ctx, "instance")
+		instanceResp, err = wait.CreateInstanceWaitHandler(waitCtx, d.client, instanceId, projectId).WaitWithContext(waitCtx)
+		err = core.WrapWaitError(waitCtx, err)
+	} else {
+		instanceResp, err = d.client.GetInstance(ctx, instanceId, projectId).Execute()
+	}
 	if err != nil {
 		utils.LogError(
 			ctx,
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -42,11 +43,17 @@ import (
 // Once this is fixed, the value should be set to 10.
 const childRouteMaxRecursionLevel = 1
 
+// Currently, due to incorrect types in the API, the update payload only accepts a single inhibit rule.
+// Once this is fixed, the limit should be removed.
+const inhibitRulesMaxItems = 1
+
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &instanceResource{}
-	_ resource.ResourceWithConfigure   = &instanceResource{}
-	_ resource.ResourceWithImportState = &instanceResource{}
+	_ resource.Resource                   = &instanceResource{}
+	_ resource.ResourceWithConfigure      = &instanceResource{}
+	_ resource.ResourceWithImportState    = &instanceResource{}
+	_ resource.ResourceWithValidateConfig = &instanceResource{}
+	_ resource.ResourceWithModifyPlan     = &instanceResource{}
 )
 
 type Model struct {
@@ -78,19 +85,49 @@ type Model struct {
 	ZipkinSpansURL                     types.String `tfsdk:"zipkin_spans_url"`
 	ACL                                types.Set    `tfsdk:"acl"`
 	AlertConfig                        types.Object `tfsdk:"alert_config"`
+	Status                             types.String `tfsdk:"status"`
+	WaitForReady                       types.Bool   `tfsdk:"wait_for_ready"`
 }
 
 // Struct corresponding to Model.AlertConfig
 type alertConfigModel struct {
 	GlobalConfiguration types.Object `tfsdk:"global"`
+	InhibitRules        types.List   `tfsdk:"inhibit_rules"`
 	Receivers           types.List   `tfsdk:"receivers"`
 	Route               types.Object `tfsdk:"route"`
 }
 
 var alertConfigTypes = map[string]attr.Type{
-	"receivers": types.ListType{ElemType: types.ObjectType{AttrTypes: receiversTypes}},
-	"route":     types.ObjectType{AttrTypes: routeTypes},
-	"global":    types.ObjectType{AttrTypes: globalConfigurationTypes},
+	"receivers":     types.ListType{ElemType: types.ObjectType{AttrTypes: receiversTypes}},
+	"route":         types.ObjectType{AttrTypes: routeTypes},
+	"global":        types.ObjectType{AttrTypes: globalConfigurationTypes},
+	"inhibit_rules": types.ListType{ElemType: types.ObjectType{AttrTypes: inhibitRulesTypes}},
+}
+
+// Struct corresponding to Model.AlertConfig.inhibit_rules
+type inhibitRulesModel struct {
+	Equal            types.List `tfsdk:"equal"`
+	SourceMatch      types.Map  `tfsdk:"source_match"`
+	SourceMatchRegex types.Map  `tfsdk:"source_match_regex"`
+	TargetMatch      types.Map  `tfsdk:"target_match"`
+	TargetMatchRegex types.Map  `tfsdk:"target_match_regex"`
+}
+
+var inhibitRulesTypes = map[string]attr.Type{
+	"equal":              types.ListType{ElemType: types.StringType},
+	"source_match":       types.MapType{ElemType: types.StringType},
+	"source_match_regex": types.MapType{ElemType: types.StringType},
+	"target_match":       types.MapType{ElemType: types.StringType},
+	"target_match_regex": types.MapType{ElemType: types.StringType},
+}
+
+var inhibitRulesDescriptions = map[string]string{
+	"main":               "List of inhibition rules. An inhibition rule mutes alerts matching the target matchers while an alert matching the source matchers is firing, e.g. to suppress alerts of downstream services during an outage. Currently, only a single inhibition rule is supported by the API.",
+	"equal":              "Labels that must have an equal value in the source and target alert for the inhibition to take effect.",
+	"source_match":       "A set of equality matchers an alert has to fulfill to inhibit other alerts.",
+	"source_match_regex": "A set of regex-matchers an alert has to fulfill to inhibit other alerts.",
+	"target_match":       "A set of equality matchers an alert has to fulfill to be inhibited.",
+	"target_match_regex": "A set of regex-matchers an alert has to fulfill to be inhibited.",
 }
 
 // Struct corresponding to Model.AlertConfig.global
@@ -401,7 +438,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"plan_name": schema.StringAttribute{
-				Description: "Specifies the Observability plan. E.g. `Observability-Monitoring-Medium-EU01`.",
+				Description: "Specifies the Observability plan. E.g. `Observability-Monitoring-Medium-EU01`. The available plans are listed by the `stackit_observability_plans` data source. Changing the plan of an existing instance produces warnings if the new plan's limits are below the current usage of the instance.",
 				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
@@ -548,6 +585,16 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: "The status of the instance, e.g. `CREATE_SUCCEEDED` or `CREATING`.",
+				Computed:    true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "If set to `false`, the instance creation is not awaited: the resource is stored right after the creation request and `status` reports its readiness on later refreshes. Cannot be combined with `acl`, `alert_config` or the metrics retention fields, which require a ready instance. Use the `stackit_observability_instance` data source with `wait_for_ready = true` to wait for the instance in dependent resources. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
 			"acl": schema.SetAttribute{
 				Description: "The access control list for this instance. Each entry is an IP address range that is permitted to access, in CIDR notation.",
 				ElementType: types.StringType,
@@ -559,7 +606,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 			"alert_config": schema.SingleNestedAttribute{
-				Description: "Alert configuration for the instance.",
+				Description: "Alert configuration for the instance. Receivers and child routes which are not part of this configuration, e.g. the ones managed by the `stackit_observability_alert_receiver` and `stackit_observability_alert_route` resources, are ignored and kept on updates.",
 				Optional:    true,
 				Attributes: map[string]schema.Attribute{
 					"receivers": schema.ListNestedAttribute{
@@ -589,6 +636,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 											"auth_password": schema.StringAttribute{
 												Description: "SMTP authentication password.",
 												Optional:    true,
+												Sensitive:   true,
 											},
 											"auth_username": schema.StringAttribute{
 												Description: "SMTP authentication username.",
@@ -620,6 +668,7 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 											"api_key": schema.StringAttribute{
 												Description: "The API key for OpsGenie.",
 												Optional:    true,
+												Sensitive:   true,
 											},
 											"api_url": schema.StringAttribute{
 												Description: "The host to send OpsGenie API requests to. Must be a valid URL",
@@ -704,6 +753,43 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 							"routes": getRouteNestedObject(),
 						},
 					},
+					"inhibit_rules": schema.ListNestedAttribute{
+						Description: inhibitRulesDescriptions["main"],
+						Optional:    true,
+						Validators: []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.SizeAtMost(inhibitRulesMaxItems),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"equal": schema.ListAttribute{
+									Description: inhibitRulesDescriptions["equal"],
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"source_match": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["source_match"],
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"source_match_regex": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["source_match_regex"],
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"target_match": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["target_match"],
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+								"target_match_regex": schema.MapAttribute{
+									Description: inhibitRulesDescriptions["target_match_regex"],
+									Optional:    true,
+									ElementType: types.StringType,
+								},
+							},
+						},
+					},
 					"global": schema.SingleNestedAttribute{
 						Description: "Global configuration for the alerts.",
 						Optional:    true,
@@ -756,6 +842,155 @@ func (r *instanceResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 	}
 }
 
+// ValidateConfig validates the resource configuration.
+func (r *instanceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// validation is done in extracted func so it's easier to unit-test it
+	validateConfig(ctx, &resp.Diagnostics, &model)
+}
+
+func validateConfig(ctx context.Context, diags *diag.Diagnostics, model *Model) {
+	if model.WaitForReady.IsNull() || model.WaitForReady.IsUnknown() || model.WaitForReady.ValueBool() {
+		return
+	}
+	// ACL, metrics retention and alert config are set through separate endpoints, which require a ready instance
+	if !model.ACL.IsNull() || !model.AlertConfig.IsNull() || !model.MetricsRetentionDays.IsNull() ||
+		!model.MetricsRetentionDays5mDownsampling.IsNull() || !model.MetricsRetentionDays1hDownsampling.IsNull() {
+		core.LogAndAddError(ctx, diags, "Error configuring instance", "The `acl`, `alert_config` and metrics retention fields can only be set if `wait_for_ready` is true.")
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to warn about the impact of changing the plan of an existing instance.
+func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	// skip creation and deletion
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+	var stateModel, planModel Model
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(planModel.PlanName) || strings.EqualFold(planModel.PlanName.ValueString(), stateModel.PlanName.ValueString()) {
+		return
+	}
+
+	projectId := stateModel.ProjectId.ValueString()
+	instanceId := stateModel.InstanceId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "instance_id", instanceId)
+
+	plansResp, err := r.client.ListPlans(ctx, projectId).Execute()
+	if err != nil {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Plan change impact not checked", fmt.Sprintf("Listing plans: %v", err))
+		return
+	}
+	currentPlan := findPlan(plansResp.Plans, stateModel.PlanName.ValueString())
+	targetPlan := findPlan(plansResp.Plans, planModel.PlanName.ValueString())
+	if targetPlan == nil {
+		// an unknown plan name is reported by loadPlanId during apply
+		return
+	}
+
+	usage, err := r.loadPlanUsage(ctx, projectId, instanceId)
+	if err != nil {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Plan change impact not checked", err.Error())
+		return
+	}
+	for _, warning := range planChangeWarnings(currentPlan, targetPlan, usage) {
+		core.LogAndAddWarning(ctx, &resp.Diagnostics, "Plan change impact", warning)
+	}
+}
+
+// planUsage holds the usage of an instance that is limited by its plan.
+type planUsage struct {
+	scrapeConfigs int64
+	scrapeTargets int64
+	alertRules    int64
+}
+
+func (r *instanceResource) loadPlanUsage(ctx context.Context, projectId, instanceId string) (planUsage, error) {
+	usage := planUsage{}
+	scrapeConfigsResp, err := r.client.ListScrapeConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		return usage, fmt.Errorf("listing scrape configs: %w", err)
+	}
+	if scrapeConfigsResp.Data != nil {
+		for _, job := range *scrapeConfigsResp.Data {
+			usage.scrapeConfigs++
+			if job.StaticConfigs == nil {
+				continue
+			}
+			for _, staticConfig := range *job.StaticConfigs {
+				if staticConfig.Targets != nil {
+					usage.scrapeTargets += int64(len(*staticConfig.Targets))
+				}
+			}
+		}
+	}
+
+	alertGroupsResp, err := r.client.ListAlertgroups(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		return usage, fmt.Errorf("listing alert groups: %w", err)
+	}
+	if alertGroupsResp.Data != nil {
+		for _, group := range *alertGroupsResp.Data {
+			if group.Rules != nil {
+				usage.alertRules += int64(len(*group.Rules))
+			}
+		}
+	}
+	return usage, nil
+}
+
+// planChangeWarnings compares the limits of the target plan with the current usage of the instance and with the
+// log and trace storage of the current plan. currentPlan may be nil if the current plan is no longer listed.
+// The plans don't expose a metrics retention, so the metrics_retention_days* attributes can't be compared.
+func planChangeWarnings(currentPlan, targetPlan *observability.Plan, usage planUsage) []string {
+	if targetPlan == nil {
+		return nil
+	}
+	targetName := targetPlan.GetName()
+	warnings := []string{}
+	if targetPlan.TargetNumber != nil && usage.scrapeTargets > *targetPlan.TargetNumber {
+		warnings = append(warnings, fmt.Sprintf("The plan %q allows %d scrape targets, but the %d scrape configs of the instance currently have %d targets.", targetName, *targetPlan.TargetNumber, usage.scrapeConfigs, usage.scrapeTargets))
+	}
+	if targetPlan.AlertRules != nil && usage.alertRules > *targetPlan.AlertRules {
+		warnings = append(warnings, fmt.Sprintf("The plan %q allows %d alert rules, but the instance currently has %d alert rules.", targetName, *targetPlan.AlertRules, usage.alertRules))
+	}
+	if currentPlan == nil {
+		return warnings
+	}
+	if currentPlan.LogsStorage != nil && targetPlan.LogsStorage != nil && *targetPlan.LogsStorage < *currentPlan.LogsStorage {
+		warnings = append(warnings, fmt.Sprintf("The plan %q has a log storage of %d, which is less than the log storage of %d of the current plan %q.", targetName, *targetPlan.LogsStorage, *currentPlan.LogsStorage, currentPlan.GetName()))
+	}
+	if currentPlan.TracesStorage != nil && targetPlan.TracesStorage != nil && *targetPlan.TracesStorage < *currentPlan.TracesStorage {
+		warnings = append(warnings, fmt.Sprintf("The plan %q has a trace storage of %d, which is less than the trace storage of %d of the current plan %q.", targetName, *targetPlan.TracesStorage, *currentPlan.TracesStorage, currentPlan.GetName()))
+	}
+	return warnings
+}
+
+// findPlan returns the plan with the given name, ignoring case, or nil if there is none.
+func findPlan(plans *[]observability.Plan, name string) *observability.Plan {
+	if plans == nil {
+		return nil
+	}
+	for i := range *plans {
+		p := (*plans)[i]
+		if p.Name != nil && strings.EqualFold(*p.Name, name) {
+			return &p
+		}
+	}
+	return nil
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *instanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
 	// Retrieve values from plan
@@ -809,9 +1044,35 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	instanceId := createResp.InstanceId
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, *instanceId, projectId).WaitWithContext(ctx)
+
+	if !(utils.IsUndefined(model.WaitForReady) || model.WaitForReady.ValueBool()) {
+		tflog.Info(ctx, "Not waiting for Observability instance to be ready")
+		instanceResp, err := r.client.GetInstanceExecute(ctx, *instanceId, projectId)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Calling API to get instance: %v", err))
+			return
+		}
+		err = mapFields(ctx, instanceResp, &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+		// The metrics retention can only be read once the instance is ready, it is refreshed on a later read
+		model.MetricsRetentionDays = types.Int64Null()
+		model.MetricsRetentionDays5mDownsampling = types.Int64Null()
+		model.MetricsRetentionDays1hDownsampling = types.Int64Null()
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, "Observability instance creation triggered")
+		return
+	}
+
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.CreateInstanceWaitHandler(waitCtx, r.client, *instanceId, projectId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance creation waiting: %v", err)))
 		return
 	}
 
@@ -971,6 +1232,24 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 		resp.State.RemoveResource(ctx)
 		return
 	}
+	// wait_for_ready is not part of the API, it is unset after an import
+	if model.WaitForReady.IsNull() {
+		model.WaitForReady = types.BoolValue(true)
+	}
+	if instanceResp != nil && instanceResp.Status != nil && *instanceResp.Status == observability.GETINSTANCERESPONSESTATUS_CREATING {
+		// The instance was created without waiting and is not ready yet, so ACL, metrics retention and alert config can't be read
+		err = mapFields(ctx, instanceResp, &model)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading instance", fmt.Sprintf("Processing API payload: %v", err))
+			return
+		}
+		resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		tflog.Info(ctx, "Observability instance read, instance is not ready yet")
+		return
+	}
 
 	aclListResp, err := r.client.ListACL(ctx, instanceId, projectId).Execute()
 	if err != nil {
@@ -1033,7 +1312,7 @@ func (r *instanceResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Map response body to schema
-	err = mapAlertConfigField(ctx, alertConfigResp, &model)
+	err = mapManagedAlertConfigField(ctx, alertConfigResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API response for the alert config: %v", err))
 		return
@@ -1115,9 +1394,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API: %v", err))
 			return
 		}
-		instance, err = wait.UpdateInstanceWaitHandler(ctx, r.client, instanceId, projectId).WaitWithContext(ctx)
+		waitCtx := core.InitWaitProgress(ctx, "instance")
+		instance, err = wait.UpdateInstanceWaitHandler(waitCtx, r.client, instanceId, projectId).WaitWithContext(waitCtx)
 		if err != nil {
-			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance update waiting: %v", err)))
 			return
 		}
 	} else {
@@ -1220,6 +1500,25 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	// Keep the receivers and child routes which are managed outside of the instance resource
+	managedAlertConfigEntries, err := getAlertConfigEntries(ctx, model.AlertConfig)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Getting managed alert config entries: %v", err))
+		return
+	}
+	previousAlertConfigEntries, err := getAlertConfigEntries(ctx, previousState.AlertConfig)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Getting previous alert config entries: %v", err))
+		return
+	}
+	managedAlertConfigEntries.add(previousAlertConfigEntries)
+	currentAlertConfigResp, err := r.client.GetAlertConfigs(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Calling API to get current alert config: %v", err))
+		return
+	}
+	addExternalAlertConfigEntries(alertConfigPayload, currentAlertConfigResp, managedAlertConfigEntries)
+
 	if alertConfigPayload != nil {
 		_, err = r.client.UpdateAlertConfigs(ctx, instanceId, projectId).UpdateAlertConfigsPayload(*alertConfigPayload).Execute()
 		if err != nil {
@@ -1236,7 +1535,7 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	// Map response body to schema
-	err = mapAlertConfigField(ctx, alertConfigResp, &model)
+	err = mapManagedAlertConfigField(ctx, alertConfigResp, &model)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Processing API response for the alert config: %v", err))
 		return
@@ -1271,9 +1570,10 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, instanceId, projectId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	_, err = wait.DeleteInstanceWaitHandler(waitCtx, r.client, instanceId, projectId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 
@@ -1281,23 +1581,49 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 }
 
 // ImportState imports a resource into the Terraform state on success.
-// The expected format of the resource import identifier is: project_id,instance_id
+// The expected format of the resource import identifier is: project_id,instance_id or project_id,name=name
 func (r *instanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	idParts := strings.Split(req.ID, core.Separator)
 
 	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
 		core.LogAndAddError(ctx, &resp.Diagnostics,
 			"Error importing instance",
-			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id]  Got: %q", req.ID),
+			fmt.Sprintf("Expected import identifier with format: [project_id],[instance_id] or [project_id],name=[name]  Got: %q", req.ID),
 		)
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), idParts[1])...)
+	projectId := idParts[0]
+	instanceId := idParts[1]
+	if name, ok := utils.ParseImportName(instanceId); ok {
+		var err error
+		instanceId, err = r.resolveInstanceId(ctx, projectId, name)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error importing instance", fmt.Sprintf("Resolving instance by name: %v", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("instance_id"), instanceId)...)
 	tflog.Info(ctx, "Observability instance state imported")
 }
 
+// resolveInstanceId returns the ID of the instance with the given name
+func (r *instanceResource) resolveInstanceId(ctx context.Context, projectId, name string) (string, error) {
+	listResp, err := r.client.ListInstances(ctx, projectId).Execute()
+	if err != nil {
+		return "", fmt.Errorf("listing instances: %w", err)
+	}
+	ids := []string{}
+	for _, instance := range listResp.GetInstances() {
+		if instance.GetName() == name && instance.GetStatus() != observability.PROJECTINSTANCEFULLSTATUS_DELETE_SUCCEEDED {
+			ids = append(ids, instance.GetId())
+		}
+	}
+	return utils.ResolveImportName("instance", name, ids)
+}
+
 func mapFields(ctx context.Context, r *observability.GetInstanceResponse, model *Model) error {
 	if r == nil {
 		return fmt.Errorf("response input is nil")
@@ -1337,6 +1663,10 @@ func mapFields(ctx context.Context, r *observability.GetInstanceResponse, model
 
 	model.IsUpdatable = types.BoolPointerValue(r.IsUpdatable)
 	model.DashboardURL = types.StringPointerValue(r.DashboardUrl)
+	model.Status = types.StringNull()
+	if r.Status != nil {
+		model.Status = types.StringValue(string(*r.Status))
+	}
 	if r.Instance != nil {
 		i := *r.Instance
 		model.GrafanaURL = types.StringPointerValue(i.GrafanaUrl)
@@ -1465,10 +1795,24 @@ func mapAlertConfigField(ctx context.Context, resp *observability.GetAlertConfig
 		return fmt.Errorf("mapping alert config global config: %w", err)
 	}
 
+	var inhibitRulesTF []inhibitRulesModel
+	if alertConfigTF != nil && !alertConfigTF.InhibitRules.IsNull() && !alertConfigTF.InhibitRules.IsUnknown() {
+		diags := alertConfigTF.InhibitRules.ElementsAs(ctx, &inhibitRulesTF, false)
+		if diags.HasError() {
+			return fmt.Errorf("mapping alert config: %w", core.DiagsToError(diags))
+		}
+	}
+
+	inhibitRules, err := mapInhibitRulesToAttributes(ctx, resp.Data.InhibitRules, inhibitRulesTF)
+	if err != nil {
+		return fmt.Errorf("mapping alert config inhibit rules: %w", err)
+	}
+
 	alertConfig, diags := types.ObjectValue(alertConfigTypes, map[string]attr.Value{
-		"receivers": receiversList,
-		"route":     route,
-		"global":    globalConfig,
+		"receivers":     receiversList,
+		"route":         route,
+		"global":        globalConfig,
+		"inhibit_rules": inhibitRules,
 	})
 	if diags.HasError() {
 		return fmt.Errorf("converting alert config to TF type: %w", core.DiagsToError(diags))
@@ -1575,9 +1919,99 @@ func getMockAlertConfig(ctx context.Context) (alertConfigModel, error) {
 		Receivers:           mockReceivers,
 		Route:               mockRoute,
 		GlobalConfiguration: mockGlobalConfig,
+		InhibitRules:        types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 	}, nil
 }
 
+// mapInhibitRulesToAttributes maps the inhibit rules returned by the API.
+// Fields the API returns empty are kept null if they are null in the inhibit rules of the state.
+func mapInhibitRulesToAttributes(ctx context.Context, respInhibitRules *[]observability.InhibitRules, stateInhibitRules []inhibitRulesModel) (basetypes.ListValue, error) {
+	nullList := types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes})
+	if respInhibitRules == nil || len(*respInhibitRules) == 0 {
+		return nullList, nil
+	}
+
+	inhibitRulesList := []attr.Value{}
+	for i, inhibitRule := range *respInhibitRules {
+		// The zero value of the model holds null fields, e.g. on import
+		inhibitRuleState := inhibitRulesModel{}
+		if i < len(stateInhibitRules) {
+			inhibitRuleState = stateInhibitRules[i]
+		}
+
+		equal, err := mapInhibitRuleEqual(ctx, inhibitRule.Equal, inhibitRuleState.Equal)
+		if err != nil {
+			return nullList, fmt.Errorf("mapping index %d, field equal: %w", i, err)
+		}
+		sourceMatch, err := mapInhibitRuleMatch(ctx, inhibitRule.SourceMatch, inhibitRuleState.SourceMatch)
+		if err != nil {
+			return nullList, fmt.Errorf("mapping index %d, field source match: %w", i, err)
+		}
+		sourceMatchRegex, err := mapInhibitRuleMatch(ctx, inhibitRule.SourceMatchRe, inhibitRuleState.SourceMatchRegex)
+		if err != nil {
+			return nullList, fmt.Errorf("mapping index %d, field source match regex: %w", i, err)
+		}
+		targetMatch, err := mapInhibitRuleMatch(ctx, inhibitRule.TargetMatch, inhibitRuleState.TargetMatch)
+		if err != nil {
+			return nullList, fmt.Errorf("mapping index %d, field target match: %w", i, err)
+		}
+		targetMatchRegex, err := mapInhibitRuleMatch(ctx, inhibitRule.TargetMatchRe, inhibitRuleState.TargetMatchRegex)
+		if err != nil {
+			return nullList, fmt.Errorf("mapping index %d, field target match regex: %w", i, err)
+		}
+
+		inhibitRuleTF, diags := types.ObjectValue(inhibitRulesTypes, map[string]attr.Value{
+			"equal":              equal,
+			"source_match":       sourceMatch,
+			"source_match_regex": sourceMatchRegex,
+			"target_match":       targetMatch,
+			"target_match_regex": targetMatchRegex,
+		})
+		if diags.HasError() {
+			return nullList, fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+		}
+		inhibitRulesList = append(inhibitRulesList, inhibitRuleTF)
+	}
+
+	inhibitRulesTF, diags := types.ListValue(types.ObjectType{AttrTypes: inhibitRulesTypes}, inhibitRulesList)
+	if diags.HasError() {
+		return nullList, fmt.Errorf("mapping inhibit rules list: %w", core.DiagsToError(diags))
+	}
+	return inhibitRulesTF, nil
+}
+
+// mapInhibitRuleEqual maps the equal labels of an inhibit rule, keeping them null if the API returned none
+// and they are null in the state.
+func mapInhibitRuleEqual(ctx context.Context, respEqual *[]string, equalTF types.List) (types.List, error) {
+	if respEqual == nil || len(*respEqual) == 0 {
+		if equalTF.IsNull() {
+			return types.ListNull(types.StringType), nil
+		}
+		return types.ListValueMust(types.StringType, []attr.Value{}), nil
+	}
+	equal, diags := types.ListValueFrom(ctx, types.StringType, respEqual)
+	if diags.HasError() {
+		return types.ListNull(types.StringType), core.DiagsToError(diags)
+	}
+	return equal, nil
+}
+
+// mapInhibitRuleMatch maps the matchers of an inhibit rule, keeping them null if the API returned none
+// and they are null in the state.
+func mapInhibitRuleMatch(ctx context.Context, respMatch *map[string]string, matchTF types.Map) (types.Map, error) {
+	if respMatch == nil || len(*respMatch) == 0 {
+		if matchTF.IsNull() {
+			return types.MapNull(types.StringType), nil
+		}
+		return types.MapValueMust(types.StringType, map[string]attr.Value{}), nil
+	}
+	match, diags := types.MapValueFrom(ctx, types.StringType, respMatch)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), core.DiagsToError(diags)
+	}
+	return match, nil
+}
+
 func mapGlobalConfigToAttributes(respGlobalConfigs *observability.Global, globalConfigsTF *globalConfigurationModel) (basetypes.ObjectValue, error) {
 	if respGlobalConfigs == nil {
 		return types.ObjectNull(globalConfigurationTypes), nil
@@ -1962,9 +2396,75 @@ func toUpdateAlertConfigPayload(ctx context.Context, model *alertConfigModel) (*
 		}
 	}
 
+	if !model.InhibitRules.IsNull() && !model.InhibitRules.IsUnknown() {
+		payload.InhibitRules, err = toInhibitRulesPayload(ctx, model)
+		if err != nil {
+			return nil, fmt.Errorf("mapping inhibit rules: %w", err)
+		}
+	}
+
 	return &payload, nil
 }
 
+func toInhibitRulesPayload(ctx context.Context, model *alertConfigModel) (*observability.UpdateAlertConfigsPayloadInhibitRules, error) {
+	if model == nil {
+		return nil, fmt.Errorf("nil model")
+	}
+	inhibitRulesTF := []inhibitRulesModel{}
+	diags := model.InhibitRules.ElementsAs(ctx, &inhibitRulesTF, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("mapping inhibit rules: %w", core.DiagsToError(diags))
+	}
+	if len(inhibitRulesTF) == 0 {
+		return nil, nil
+	}
+	if len(inhibitRulesTF) > inhibitRulesMaxItems {
+		return nil, fmt.Errorf("only %d inhibit rule can be set, got %d", inhibitRulesMaxItems, len(inhibitRulesTF))
+	}
+	inhibitRuleTF := inhibitRulesTF[0]
+
+	payload := observability.UpdateAlertConfigsPayloadInhibitRules{}
+	if !inhibitRuleTF.Equal.IsNull() && !inhibitRuleTF.Equal.IsUnknown() {
+		equal := []string{}
+		diags = inhibitRuleTF.Equal.ElementsAs(ctx, &equal, false)
+		if diags.HasError() {
+			return nil, fmt.Errorf("mapping equal: %w", core.DiagsToError(diags))
+		}
+		payload.Equal = &equal
+	}
+
+	var err error
+	payload.SourceMatch, err = toMatchPayload(ctx, inhibitRuleTF.SourceMatch)
+	if err != nil {
+		return nil, fmt.Errorf("mapping source match: %w", err)
+	}
+	payload.SourceMatchRe, err = toMatchPayload(ctx, inhibitRuleTF.SourceMatchRegex)
+	if err != nil {
+		return nil, fmt.Errorf("mapping source match regex: %w", err)
+	}
+	payload.TargetMatch, err = toMatchPayload(ctx, inhibitRuleTF.TargetMatch)
+	if err != nil {
+		return nil, fmt.Errorf("mapping target match: %w", err)
+	}
+	payload.TargetMatchRe, err = toMatchPayload(ctx, inhibitRuleTF.TargetMatchRegex)
+	if err != nil {
+		return nil, fmt.Errorf("mapping target match regex: %w", err)
+	}
+	return &payload, nil
+}
+
+// toMatchPayload converts a map of matchers to the payload, returning nil if the map is null or unknown.
+func toMatchPayload(ctx context.Context, match types.Map) (*map[string]interface{}, error) {
+	if match.IsNull() || match.IsUnknown() {
+		return nil, nil
+	}
+	matchMap, err := conversion.ToStringInterfaceMap(ctx, match)
+	if err != nil {
+		return nil, err
+	}
+	return &matchMap, nil
+}
+
 func toReceiverPayload(ctx context.Context, model *alertConfigModel) (*[]observability.UpdateAlertConfigsPayloadReceiversInner, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
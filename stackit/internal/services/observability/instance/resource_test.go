@@ -2,17 +2,26 @@ package observability
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
 	"github.com/stackitcloud/stackit-sdk-go/core/utils"
 	"github.com/stackitcloud/stackit-sdk-go/services/observability"
 )
@@ -368,6 +377,7 @@ func TestMapFields(t *testing.T) {
 				PlanName:   utils.Ptr("plan1"),
 				PlanId:     utils.Ptr("planId"),
 				Parameters: &map[string]string{"key": "value"},
+				Status:     observability.GETINSTANCERESPONSESTATUS_CREATE_SUCCEEDED.Ptr(),
 				Instance: &observability.InstanceSensitiveData{
 					MetricsRetentionTimeRaw: utils.Ptr(int64(60)),
 					MetricsRetentionTime1h:  utils.Ptr(int64(30)),
@@ -399,6 +409,7 @@ func TestMapFields(t *testing.T) {
 				MetricsRetentionDays:               types.Int64Value(60),
 				MetricsRetentionDays1hDownsampling: types.Int64Value(30),
 				MetricsRetentionDays5mDownsampling: types.Int64Value(7),
+				Status:                             types.StringValue(string(observability.GETINSTANCERESPONSESTATUS_CREATE_SUCCEEDED)),
 			},
 			true,
 		},
@@ -584,12 +595,219 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestValidateConfig(t *testing.T) {
+	tests := []struct {
+		description string
+		model       Model
+		isValid     bool
+	}{
+		{
+			"wait_for_ready_not_set",
+			Model{
+				ACL:                  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("1.1.1.1/32")}),
+				MetricsRetentionDays: types.Int64Value(60),
+			},
+			true,
+		},
+		{
+			"wait_for_ready_true",
+			Model{
+				WaitForReady:         types.BoolValue(true),
+				ACL:                  types.SetValueMust(types.StringType, []attr.Value{types.StringValue("1.1.1.1/32")}),
+				MetricsRetentionDays: types.Int64Value(60),
+			},
+			true,
+		},
+		{
+			"wait_for_ready_false",
+			Model{
+				WaitForReady:                       types.BoolValue(false),
+				ACL:                                types.SetNull(types.StringType),
+				AlertConfig:                        types.ObjectNull(alertConfigTypes),
+				MetricsRetentionDays:               types.Int64Null(),
+				MetricsRetentionDays5mDownsampling: types.Int64Null(),
+				MetricsRetentionDays1hDownsampling: types.Int64Null(),
+			},
+			true,
+		},
+		{
+			"wait_for_ready_false_with_acl",
+			Model{
+				WaitForReady:                       types.BoolValue(false),
+				ACL:                                types.SetValueMust(types.StringType, []attr.Value{types.StringValue("1.1.1.1/32")}),
+				AlertConfig:                        types.ObjectNull(alertConfigTypes),
+				MetricsRetentionDays:               types.Int64Null(),
+				MetricsRetentionDays5mDownsampling: types.Int64Null(),
+				MetricsRetentionDays1hDownsampling: types.Int64Null(),
+			},
+			false,
+		},
+		{
+			"wait_for_ready_false_with_metrics_retention",
+			Model{
+				WaitForReady:                       types.BoolValue(false),
+				ACL:                                types.SetNull(types.StringType),
+				AlertConfig:                        types.ObjectNull(alertConfigTypes),
+				MetricsRetentionDays:               types.Int64Value(60),
+				MetricsRetentionDays5mDownsampling: types.Int64Null(),
+				MetricsRetentionDays1hDownsampling: types.Int64Null(),
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			validateConfig(context.Background(), &diags, &tt.model)
+			if diags.HasError() == tt.isValid {
+				t.Fatalf("Expected valid: %t, got errors: %v", tt.isValid, diags.Errors())
+			}
+		})
+	}
+}
+
+func TestPlanChangeWarnings(t *testing.T) {
+	plan := func(name string, targets, alertRules, logsStorage, tracesStorage int64) *observability.Plan {
+		return &observability.Plan{
+			Name:          utils.Ptr(name),
+			TargetNumber:  utils.Ptr(targets),
+			AlertRules:    utils.Ptr(alertRules),
+			LogsStorage:   utils.Ptr(logsStorage),
+			TracesStorage: utils.Ptr(tracesStorage),
+		}
+	}
+	large := plan("Observability-Large-EU01", 1000, 1000, 30, 30)
+	small := plan("Observability-Small-EU01", 10, 50, 7, 7)
+
+	tests := []struct {
+		description string
+		currentPlan *observability.Plan
+		targetPlan  *observability.Plan
+		usage       planUsage
+		expected    []string
+	}{
+		{
+			"upgrade",
+			small,
+			large,
+			planUsage{scrapeConfigs: 2, scrapeTargets: 10, alertRules: 50},
+			[]string{},
+		},
+		{
+			"downgrade_within_limits",
+			large,
+			small,
+			planUsage{scrapeConfigs: 2, scrapeTargets: 10, alertRules: 50},
+			[]string{
+				`The plan "Observability-Small-EU01" has a log storage of 7, which is less than the log storage of 30 of the current plan "Observability-Large-EU01".`,
+				`The plan "Observability-Small-EU01" has a trace storage of 7, which is less than the trace storage of 30 of the current plan "Observability-Large-EU01".`,
+			},
+		},
+		{
+			"downgrade_exceeding_limits",
+			large,
+			small,
+			planUsage{scrapeConfigs: 3, scrapeTargets: 11, alertRules: 51},
+			[]string{
+				`The plan "Observability-Small-EU01" allows 10 scrape targets, but the 3 scrape configs of the instance currently have 11 targets.`,
+				`The plan "Observability-Small-EU01" allows 50 alert rules, but the instance currently has 51 alert rules.`,
+				`The plan "Observability-Small-EU01" has a log storage of 7, which is less than the log storage of 30 of the current plan "Observability-Large-EU01".`,
+				`The plan "Observability-Small-EU01" has a trace storage of 7, which is less than the trace storage of 30 of the current plan "Observability-Large-EU01".`,
+			},
+		},
+		{
+			"current_plan_unknown",
+			nil,
+			small,
+			planUsage{scrapeConfigs: 1, scrapeTargets: 20, alertRules: 0},
+			[]string{
+				`The plan "Observability-Small-EU01" allows 10 scrape targets, but the 1 scrape configs of the instance currently have 20 targets.`,
+			},
+		},
+		{
+			"target_plan_nil",
+			large,
+			nil,
+			planUsage{},
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := planChangeWarnings(tt.currentPlan, tt.targetPlan, tt.usage)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFindPlan(t *testing.T) {
+	plans := &[]observability.Plan{
+		{Name: nil, PlanId: utils.Ptr("no-name")},
+		{Name: utils.Ptr("Observability-Basic-EU01"), PlanId: utils.Ptr("basic")},
+		{Name: utils.Ptr("Observability-Large-EU01"), PlanId: utils.Ptr("large")},
+	}
+	tests := []struct {
+		description string
+		plans       *[]observability.Plan
+		name        string
+		expected    *string
+	}{
+		{"found", plans, "Observability-Large-EU01", utils.Ptr("large")},
+		{"case_insensitive", plans, "observability-basic-eu01", utils.Ptr("basic")},
+		{"not_found", plans, "Observability-Medium-EU01", nil},
+		{"nil_plans", nil, "Observability-Large-EU01", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := findPlan(tt.plans, tt.name)
+			if tt.expected == nil {
+				if output != nil {
+					t.Fatalf("Expected no plan, got %v", output)
+				}
+				return
+			}
+			if output == nil || *output.PlanId != *tt.expected {
+				t.Fatalf("Expected plan %q, got %v", *tt.expected, output)
+			}
+		})
+	}
+}
+
 func TestMapAlertConfigField(t *testing.T) {
+	partialInhibitRuleAlertConfig := types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
+		"receivers": types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{
+			fixtureReceiverModel(
+				fixtureEmailConfigsModel(),
+				fixtureOpsGenieConfigsModel(),
+				fixtureWebHooksConfigsModel(),
+			),
+		}),
+		"route":  fixtureRouteModel(),
+		"global": fixtureGlobalConfigModel(),
+		"inhibit_rules": types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+			types.ObjectValueMust(inhibitRulesTypes, map[string]attr.Value{
+				"equal": types.ListNull(types.StringType),
+				"source_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"severity": types.StringValue("critical"),
+				}),
+				"source_match_regex": types.MapNull(types.StringType),
+				"target_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+					"severity": types.StringValue("warning"),
+				}),
+				"target_match_regex": types.MapNull(types.StringType),
+			}),
+		}),
+	})
+
 	tests := []struct {
-		description     string
-		alertConfigResp *observability.GetAlertConfigsResponse
-		expected        Model
-		isValid         bool
+		description      string
+		alertConfigResp  *observability.GetAlertConfigsResponse
+		alertConfigState types.Object
+		expected         Model
+		isValid          bool
 	}{
 		{
 			description: "basic_ok",
@@ -623,8 +841,9 @@ func TestMapAlertConfigField(t *testing.T) {
 							fixtureWebHooksConfigsModel(),
 						),
 					}),
-					"route":  fixtureRouteModel(),
-					"global": fixtureGlobalConfigModel(),
+					"route":         fixtureRouteModel(),
+					"global":        fixtureGlobalConfigModel(),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -656,8 +875,9 @@ func TestMapAlertConfigField(t *testing.T) {
 							types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
 						),
 					}),
-					"route":  fixtureRouteModel(),
-					"global": types.ObjectNull(globalConfigurationTypes),
+					"route":         fixtureRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -689,8 +909,9 @@ func TestMapAlertConfigField(t *testing.T) {
 							types.ListNull(types.ObjectType{AttrTypes: webHooksConfigsTypes}),
 						),
 					}),
-					"route":  fixtureRouteModel(),
-					"global": types.ObjectNull(globalConfigurationTypes),
+					"route":         fixtureRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -722,8 +943,9 @@ func TestMapAlertConfigField(t *testing.T) {
 							fixtureWebHooksConfigsModel(),
 						),
 					}),
-					"route":  fixtureRouteModel(),
-					"global": types.ObjectNull(globalConfigurationTypes),
+					"route":         fixtureRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -740,9 +962,10 @@ func TestMapAlertConfigField(t *testing.T) {
 				ACL:        types.SetNull(types.StringType),
 				Parameters: types.MapNull(types.StringType),
 				AlertConfig: types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
-					"receivers": types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{}),
-					"route":     fixtureNullRouteModel(),
-					"global":    types.ObjectNull(globalConfigurationTypes),
+					"receivers":     types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{}),
+					"route":         fixtureNullRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -759,9 +982,10 @@ func TestMapAlertConfigField(t *testing.T) {
 				ACL:        types.SetNull(types.StringType),
 				Parameters: types.MapNull(types.StringType),
 				AlertConfig: types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
-					"receivers": types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{}),
-					"route":     fixtureRouteModel(),
-					"global":    types.ObjectNull(globalConfigurationTypes),
+					"receivers":     types.ListValueMust(types.ObjectType{AttrTypes: receiversTypes}, []attr.Value{}),
+					"route":         fixtureRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -797,8 +1021,9 @@ func TestMapAlertConfigField(t *testing.T) {
 							fixtureWebHooksConfigsModel(),
 						),
 					}),
-					"route":  fixtureNullRouteModel(),
-					"global": types.ObjectNull(globalConfigurationTypes),
+					"route":         fixtureNullRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -815,9 +1040,10 @@ func TestMapAlertConfigField(t *testing.T) {
 				ACL:        types.SetNull(types.StringType),
 				Parameters: types.MapNull(types.StringType),
 				AlertConfig: types.ObjectValueMust(alertConfigTypes, map[string]attr.Value{
-					"receivers": types.ListNull(types.ObjectType{AttrTypes: receiversTypes}),
-					"route":     fixtureRouteModel(),
-					"global":    types.ObjectNull(globalConfigurationTypes),
+					"receivers":     types.ListNull(types.ObjectType{AttrTypes: receiversTypes}),
+					"route":         fixtureRouteModel(),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -853,8 +1079,9 @@ func TestMapAlertConfigField(t *testing.T) {
 							fixtureWebHooksConfigsModel(),
 						),
 					}),
-					"route":  types.ObjectNull(routeTypes),
-					"global": types.ObjectNull(globalConfigurationTypes),
+					"route":         types.ObjectNull(routeTypes),
+					"global":        types.ObjectNull(globalConfigurationTypes),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
@@ -891,12 +1118,51 @@ func TestMapAlertConfigField(t *testing.T) {
 							fixtureWebHooksConfigsModel(),
 						),
 					}),
-					"route":  fixtureRouteModel(),
-					"global": fixtureNullGlobalConfigModel(),
+					"route":         fixtureRouteModel(),
+					"global":        fixtureNullGlobalConfigModel(),
+					"inhibit_rules": types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
 				}),
 			},
 			isValid: true,
 		},
+		{
+			description: "partially specified inhibit rule",
+			alertConfigResp: &observability.GetAlertConfigsResponse{
+				Data: &observability.Alert{
+					Receivers: &[]observability.Receivers{
+						fixtureReceiverResponse(
+							&[]observability.EmailConfig{
+								fixtureEmailConfigsResponse(),
+							},
+							&[]observability.OpsgenieConfig{
+								fixtureOpsGenieConfigsResponse(),
+							},
+							&[]observability.WebHook{
+								fixtureWebHooksConfigsResponse(),
+							},
+						),
+					},
+					Route:  fixtureRouteResponse(),
+					Global: fixtureGlobalConfigResponse(),
+					InhibitRules: &[]observability.InhibitRules{
+						{
+							Equal:         &[]string{},
+							SourceMatch:   &map[string]string{"severity": "critical"},
+							SourceMatchRe: &map[string]string{},
+							TargetMatch:   &map[string]string{"severity": "warning"},
+							TargetMatchRe: &map[string]string{},
+						},
+					},
+				},
+			},
+			alertConfigState: partialInhibitRuleAlertConfig,
+			expected: Model{
+				ACL:         types.SetNull(types.StringType),
+				Parameters:  types.MapNull(types.StringType),
+				AlertConfig: partialInhibitRuleAlertConfig,
+			},
+			isValid: true,
+		},
 		{
 			description:     "nil resp",
 			alertConfigResp: nil,
@@ -912,9 +1178,10 @@ func TestMapAlertConfigField(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.description, func(t *testing.T) {
 			state := &Model{
-				ProjectId:  tt.expected.ProjectId,
-				ACL:        types.SetNull(types.StringType),
-				Parameters: types.MapNull(types.StringType),
+				ProjectId:   tt.expected.ProjectId,
+				ACL:         types.SetNull(types.StringType),
+				Parameters:  types.MapNull(types.StringType),
+				AlertConfig: tt.alertConfigState,
 			}
 			err := mapAlertConfigField(context.Background(), tt.alertConfigResp, state)
 			if !tt.isValid && err == nil {
@@ -1351,6 +1618,234 @@ func TestToUpdateAlertConfigPayload(t *testing.T) {
 	}
 }
 
+func TestMapInhibitRulesToAttributes(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *[]observability.InhibitRules
+		state       []inhibitRulesModel
+		expected    basetypes.ListValue
+		isValid     bool
+	}{
+		{
+			"ok",
+			&[]observability.InhibitRules{
+				{
+					Equal:         &[]string{"cluster", "namespace"},
+					SourceMatch:   &map[string]string{"severity": "critical"},
+					SourceMatchRe: &map[string]string{"alertname": "Node.*"},
+					TargetMatch:   &map[string]string{"severity": "warning"},
+				},
+			},
+			nil,
+			types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+				types.ObjectValueMust(inhibitRulesTypes, map[string]attr.Value{
+					"equal": types.ListValueMust(types.StringType, []attr.Value{
+						types.StringValue("cluster"),
+						types.StringValue("namespace"),
+					}),
+					"source_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"severity": types.StringValue("critical"),
+					}),
+					"source_match_regex": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"alertname": types.StringValue("Node.*"),
+					}),
+					"target_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"severity": types.StringValue("warning"),
+					}),
+					"target_match_regex": types.MapNull(types.StringType),
+				}),
+			}),
+			true,
+		},
+		{
+			"empty_fields_null_in_state",
+			&[]observability.InhibitRules{
+				{
+					Equal:         &[]string{},
+					SourceMatch:   &map[string]string{"severity": "critical"},
+					SourceMatchRe: &map[string]string{},
+					TargetMatch:   &map[string]string{},
+				},
+			},
+			[]inhibitRulesModel{
+				{
+					Equal:            types.ListNull(types.StringType),
+					SourceMatch:      types.MapValueMust(types.StringType, map[string]attr.Value{"severity": types.StringValue("critical")}),
+					SourceMatchRegex: types.MapNull(types.StringType),
+					TargetMatch:      types.MapNull(types.StringType),
+					TargetMatchRegex: types.MapNull(types.StringType),
+				},
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+				types.ObjectValueMust(inhibitRulesTypes, map[string]attr.Value{
+					"equal": types.ListNull(types.StringType),
+					"source_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"severity": types.StringValue("critical"),
+					}),
+					"source_match_regex": types.MapNull(types.StringType),
+					"target_match":       types.MapNull(types.StringType),
+					"target_match_regex": types.MapNull(types.StringType),
+				}),
+			}),
+			true,
+		},
+		{
+			"empty_fields_set_in_state",
+			&[]observability.InhibitRules{
+				{
+					SourceMatch: &map[string]string{"severity": "critical"},
+				},
+			},
+			[]inhibitRulesModel{
+				{
+					Equal:            types.ListValueMust(types.StringType, []attr.Value{}),
+					SourceMatch:      types.MapValueMust(types.StringType, map[string]attr.Value{"severity": types.StringValue("critical")}),
+					SourceMatchRegex: types.MapNull(types.StringType),
+					TargetMatch:      types.MapValueMust(types.StringType, map[string]attr.Value{}),
+					TargetMatchRegex: types.MapNull(types.StringType),
+				},
+			},
+			types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+				types.ObjectValueMust(inhibitRulesTypes, map[string]attr.Value{
+					"equal": types.ListValueMust(types.StringType, []attr.Value{}),
+					"source_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+						"severity": types.StringValue("critical"),
+					}),
+					"source_match_regex": types.MapNull(types.StringType),
+					"target_match":       types.MapValueMust(types.StringType, map[string]attr.Value{}),
+					"target_match_regex": types.MapNull(types.StringType),
+				}),
+			}),
+			true,
+		},
+		{
+			"empty",
+			&[]observability.InhibitRules{},
+			nil,
+			types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
+			true,
+		},
+		{
+			"nil",
+			nil,
+			nil,
+			types.ListNull(types.ObjectType{AttrTypes: inhibitRulesTypes}),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := mapInhibitRulesToAttributes(context.Background(), tt.input, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestToInhibitRulesPayload(t *testing.T) {
+	inhibitRule := func(equal basetypes.ListValue, targetMatchRegex basetypes.MapValue) attr.Value {
+		return types.ObjectValueMust(inhibitRulesTypes, map[string]attr.Value{
+			"equal": equal,
+			"source_match": types.MapValueMust(types.StringType, map[string]attr.Value{
+				"severity": types.StringValue("critical"),
+			}),
+			"source_match_regex": types.MapNull(types.StringType),
+			"target_match":       types.MapNull(types.StringType),
+			"target_match_regex": targetMatchRegex,
+		})
+	}
+	tests := []struct {
+		description string
+		input       *alertConfigModel
+		expected    *observability.UpdateAlertConfigsPayloadInhibitRules
+		isValid     bool
+	}{
+		{
+			"ok",
+			&alertConfigModel{
+				InhibitRules: types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+					inhibitRule(
+						types.ListValueMust(types.StringType, []attr.Value{types.StringValue("cluster")}),
+						types.MapValueMust(types.StringType, map[string]attr.Value{
+							"severity": types.StringValue("warning|info"),
+						}),
+					),
+				}),
+			},
+			&observability.UpdateAlertConfigsPayloadInhibitRules{
+				Equal:         &[]string{"cluster"},
+				SourceMatch:   &map[string]interface{}{"severity": "critical"},
+				TargetMatchRe: &map[string]interface{}{"severity": "warning|info"},
+			},
+			true,
+		},
+		{
+			"null_fields",
+			&alertConfigModel{
+				InhibitRules: types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+					inhibitRule(types.ListNull(types.StringType), types.MapNull(types.StringType)),
+				}),
+			},
+			&observability.UpdateAlertConfigsPayloadInhibitRules{
+				SourceMatch: &map[string]interface{}{"severity": "critical"},
+			},
+			true,
+		},
+		{
+			"empty",
+			&alertConfigModel{
+				InhibitRules: types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{}),
+			},
+			nil,
+			true,
+		},
+		{
+			"too_many",
+			&alertConfigModel{
+				InhibitRules: types.ListValueMust(types.ObjectType{AttrTypes: inhibitRulesTypes}, []attr.Value{
+					inhibitRule(types.ListNull(types.StringType), types.MapNull(types.StringType)),
+					inhibitRule(types.ListNull(types.StringType), types.MapNull(types.StringType)),
+				}),
+			},
+			nil,
+			false,
+		},
+		{
+			"nil_model",
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, err := toInhibitRulesPayload(context.Background(), tt.input)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestGetRouteNestedObjectAux(t *testing.T) {
 	tests := []struct {
 		description    string
@@ -1560,3 +2055,115 @@ func toTerraformStringMapMust(ctx context.Context, m map[string]string) basetype
 	}
 	return res
 }
+
+func TestImportState(t *testing.T) {
+	tests := []struct {
+		description       string
+		importId          string
+		listResponse      *observability.ListInstancesResponse
+		expectedId        string
+		expectedListCalls int
+		isValid           bool
+	}{
+		{
+			"id",
+			"pid,iid",
+			nil,
+			"iid",
+			0,
+			true,
+		},
+		{
+			"name",
+			"pid,name=example",
+			&observability.ListInstancesResponse{Instances: &[]observability.ProjectInstanceFull{{Id: utils.Ptr("iid"), Name: utils.Ptr("example"), Status: observability.PROJECTINSTANCEFULLSTATUS_CREATE_SUCCEEDED.Ptr()}, {Id: utils.Ptr("iid-deleted"), Name: utils.Ptr("example"), Status: observability.PROJECTINSTANCEFULLSTATUS_DELETE_SUCCEEDED.Ptr()}}},
+			"iid",
+			1,
+			true,
+		},
+		{
+			"name_not_found",
+			"pid,name=example",
+			&observability.ListInstancesResponse{Instances: &[]observability.ProjectInstanceFull{{Id: utils.Ptr("iid-2"), Name: utils.Ptr("other"), Status: observability.PROJECTINSTANCEFULLSTATUS_CREATE_SUCCEEDED.Ptr()}}},
+			"",
+			1,
+			false,
+		},
+		{
+			"name_not_unique",
+			"pid,name=example",
+			&observability.ListInstancesResponse{Instances: &[]observability.ProjectInstanceFull{{Id: utils.Ptr("iid"), Name: utils.Ptr("example"), Status: observability.PROJECTINSTANCEFULLSTATUS_CREATE_SUCCEEDED.Ptr()}, {Id: utils.Ptr("iid-2"), Name: utils.Ptr("example"), Status: observability.PROJECTINSTANCEFULLSTATUS_CREATE_SUCCEEDED.Ptr()}}},
+			"",
+			1,
+			false,
+		},
+		{
+			"empty_name",
+			"pid,name=",
+			nil,
+			"name=",
+			0,
+			true,
+		},
+		{
+			"invalid_format",
+			"pid",
+			nil,
+			"",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			listCalls := 0
+			mockedServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				listCalls++
+				w.Header().Set("Content-Type", "application/json")
+				if err := json.NewEncoder(w).Encode(tt.listResponse); err != nil {
+					t.Errorf("Failed to encode response: %v", err)
+				}
+			}))
+			defer mockedServer.Close()
+			client, err := observability.NewAPIClient(
+				config.WithEndpoint(mockedServer.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+			r := &instanceResource{client: client}
+
+			ctx := context.Background()
+			schemaResp := &resource.SchemaResponse{}
+			r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+			resp := &resource.ImportStateResponse{
+				State: tfsdk.State{
+					Schema: schemaResp.Schema,
+					Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+				},
+			}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.importId}, resp)
+			if !tt.isValid && !resp.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && resp.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", resp.Diagnostics.Errors())
+			}
+			if listCalls != tt.expectedListCalls {
+				t.Fatalf("expected %d list calls, got %d", tt.expectedListCalls, listCalls)
+			}
+			if tt.isValid {
+				var projectId, id types.String
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("project_id"), &projectId)...)
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("instance_id"), &id)...)
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("Failed to read state: %v", resp.Diagnostics.Errors())
+				}
+				if projectId.ValueString() != "pid" || id.ValueString() != tt.expectedId {
+					t.Fatalf("expected project_id %q and instance_id %q, got %q and %q", "pid", tt.expectedId, projectId.ValueString(), id.ValueString())
+				}
+			}
+		})
+	}
+}
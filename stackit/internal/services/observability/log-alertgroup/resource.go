@@ -158,7 +158,7 @@ func (l *logAlertGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 				Optional:    true,
 				Computed:    true,
 				Validators: []validator.String{
-					validate.ValidDurationString(),
+					validate.PrometheusDuration(),
 				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
@@ -192,6 +192,7 @@ func (l *logAlertGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 								// leading to inconsistent Terraform results. This issue has been reported to the Obs team.
 								// Until it is resolved, we proactively notify users if their input contains a trailing newline.
 								validate.ValidNoTrailingNewline(),
+								validate.LogQL(),
 							},
 						},
 						"for": schema.StringAttribute{
@@ -199,7 +200,7 @@ func (l *logAlertGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 							Optional:    true,
 							Validators: []validator.String{
 								stringvalidator.LengthBetween(2, 8),
-								validate.ValidDurationString(),
+								validate.PrometheusDuration(),
 							},
 						},
 						"labels": schema.MapAttribute{
@@ -207,7 +208,10 @@ func (l *logAlertGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 							Optional:    true,
 							ElementType: types.StringType,
 							Validators: []validator.Map{
-								mapvalidator.KeysAre(stringvalidator.LengthAtMost(200)),
+								mapvalidator.KeysAre(
+									stringvalidator.LengthAtMost(200),
+									validate.PrometheusLabelName(),
+								),
 								mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(200)),
 								mapvalidator.SizeAtMost(10),
 							},
@@ -217,7 +221,10 @@ func (l *logAlertGroupResource) Schema(_ context.Context, _ resource.SchemaReque
 							Optional:    true,
 							ElementType: types.StringType,
 							Validators: []validator.Map{
-								mapvalidator.KeysAre(stringvalidator.LengthAtMost(200)),
+								mapvalidator.KeysAre(
+									stringvalidator.LengthAtMost(200),
+									validate.PrometheusLabelName(),
+								),
 								mapvalidator.ValueStringsAre(stringvalidator.LengthAtMost(200)),
 								mapvalidator.SizeAtMost(5),
 							},
@@ -0,0 +1,295 @@
+package plans
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &plansDataSource{}
+)
+
+// NewPlansDataSource is a helper function to simplify the provider implementation.
+func NewPlansDataSource() datasource.DataSource {
+	return &plansDataSource{}
+}
+
+// plansDataSource is the data source implementation.
+type plansDataSource struct {
+	client *observability.APIClient
+}
+
+type Model struct {
+	Id        types.String `tfsdk:"id"` // needed by TF
+	ProjectId types.String `tfsdk:"project_id"`
+	Plans     types.List   `tfsdk:"plans"`
+}
+
+var planTypes = map[string]attr.Type{
+	"id":                        types.StringType,
+	"plan_id":                   types.StringType,
+	"name":                      types.StringType,
+	"description":               types.StringType,
+	"is_free":                   types.BoolType,
+	"is_public":                 types.BoolType,
+	"amount":                    types.Float64Type,
+	"samples_per_scrape":        types.Int64Type,
+	"target_number":             types.Int64Type,
+	"total_metric_samples":      types.Int64Type,
+	"alert_rules":               types.Int64Type,
+	"alert_receivers":           types.Int64Type,
+	"alert_matchers":            types.Int64Type,
+	"logs_alert":                types.Int64Type,
+	"logs_storage":              types.Int64Type,
+	"traces_storage":            types.Int64Type,
+	"bucket_size":               types.Int64Type,
+	"grafana_global_dashboards": types.Int64Type,
+	"grafana_global_orgs":       types.Int64Type,
+	"grafana_global_sessions":   types.Int64Type,
+	"grafana_global_users":      types.Int64Type,
+}
+
+// Metadata returns the data source type name.
+func (d *plansDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_observability_plans"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *plansDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	providerData, ok := conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := observabilityUtils.ConfigureClient(ctx, &providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "Observability client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *plansDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Observability plans data source schema. Lists the plans available for observability instances, together with their limits. Use the `name` of a plan as `plan_name` of the `stackit_observability_instance` resource."
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: description,
+		Description:         description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It takes the value of \"`project_id`\".",
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID for which the plans are listed.",
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"plans": schema.ListNestedAttribute{
+				Description: "The available observability plans.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the plan.",
+							Computed:    true,
+						},
+						"plan_id": schema.StringAttribute{
+							Description: "The plan ID, as used by the `plan_id` attribute of the `stackit_observability_instance` resource.",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the plan, as used by the `plan_name` attribute of the `stackit_observability_instance` resource.",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the plan.",
+							Computed:    true,
+						},
+						"is_free": schema.BoolAttribute{
+							Description: "Whether the plan is free of charge.",
+							Computed:    true,
+						},
+						"is_public": schema.BoolAttribute{
+							Description: "Whether the plan is publicly available.",
+							Computed:    true,
+						},
+						"amount": schema.Float64Attribute{
+							Description: "The price of the plan.",
+							Computed:    true,
+						},
+						"samples_per_scrape": schema.Int64Attribute{
+							Description: "The maximum number of samples per scrape.",
+							Computed:    true,
+						},
+						"target_number": schema.Int64Attribute{
+							Description: "The maximum number of scrape targets.",
+							Computed:    true,
+						},
+						"total_metric_samples": schema.Int64Attribute{
+							Description: "The maximum number of metric samples.",
+							Computed:    true,
+						},
+						"alert_rules": schema.Int64Attribute{
+							Description: "The maximum number of alert rules.",
+							Computed:    true,
+						},
+						"alert_receivers": schema.Int64Attribute{
+							Description: "The maximum number of alert receivers.",
+							Computed:    true,
+						},
+						"alert_matchers": schema.Int64Attribute{
+							Description: "The maximum number of alert matchers.",
+							Computed:    true,
+						},
+						"logs_alert": schema.Int64Attribute{
+							Description: "The maximum number of log alerts.",
+							Computed:    true,
+						},
+						"logs_storage": schema.Int64Attribute{
+							Description: "The log storage of the plan.",
+							Computed:    true,
+						},
+						"traces_storage": schema.Int64Attribute{
+							Description: "The trace storage of the plan.",
+							Computed:    true,
+						},
+						"bucket_size": schema.Int64Attribute{
+							Description: "The bucket size of the plan.",
+							Computed:    true,
+						},
+						"grafana_global_dashboards": schema.Int64Attribute{
+							Description: "The maximum number of Grafana dashboards.",
+							Computed:    true,
+						},
+						"grafana_global_orgs": schema.Int64Attribute{
+							Description: "The maximum number of Grafana organizations.",
+							Computed:    true,
+						},
+						"grafana_global_sessions": schema.Int64Attribute{
+							Description: "The maximum number of concurrent Grafana sessions.",
+							Computed:    true,
+						},
+						"grafana_global_users": schema.Int64Attribute{
+							Description: "The maximum number of Grafana users.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *plansDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+
+	plansResp, err := d.client.ListPlans(ctx, projectId).Execute()
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading observability plans",
+			fmt.Sprintf("Plans for project %q cannot be listed.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapFields(plansResp, &model)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading observability plans", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "Observability plans read")
+}
+
+func mapFields(plansResp *observability.PlansResponse, model *Model) error {
+	if plansResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString())
+
+	plans := []attr.Value{}
+	if plansResp.Plans != nil {
+		for i := range *plansResp.Plans {
+			plan := (*plansResp.Plans)[i]
+			planValues := map[string]attr.Value{
+				"id":                        types.StringPointerValue(plan.Id),
+				"plan_id":                   types.StringPointerValue(plan.PlanId),
+				"name":                      types.StringPointerValue(plan.Name),
+				"description":               types.StringPointerValue(plan.Description),
+				"is_free":                   types.BoolPointerValue(plan.IsFree),
+				"is_public":                 types.BoolPointerValue(plan.IsPublic),
+				"amount":                    types.Float64PointerValue(plan.Amount),
+				"samples_per_scrape":        types.Int64PointerValue(plan.SamplesPerScrape),
+				"target_number":             types.Int64PointerValue(plan.TargetNumber),
+				"total_metric_samples":      types.Int64PointerValue(plan.TotalMetricSamples),
+				"alert_rules":               types.Int64PointerValue(plan.AlertRules),
+				"alert_receivers":           types.Int64PointerValue(plan.AlertReceivers),
+				"alert_matchers":            types.Int64PointerValue(plan.AlertMatchers),
+				"logs_alert":                types.Int64PointerValue(plan.LogsAlert),
+				"logs_storage":              types.Int64PointerValue(plan.LogsStorage),
+				"traces_storage":            types.Int64PointerValue(plan.TracesStorage),
+				"bucket_size":               types.Int64PointerValue(plan.BucketSize),
+				"grafana_global_dashboards": types.Int64PointerValue(plan.GrafanaGlobalDashboards),
+				"grafana_global_orgs":       types.Int64PointerValue(plan.GrafanaGlobalOrgs),
+				"grafana_global_sessions":   types.Int64PointerValue(plan.GrafanaGlobalSessions),
+				"grafana_global_users":      types.Int64PointerValue(plan.GrafanaGlobalUsers),
+			}
+			planObject, diags := types.ObjectValue(planTypes, planValues)
+			if diags.HasError() {
+				return fmt.Errorf("mapping plan %d: %w", i, core.DiagsToError(diags))
+			}
+			plans = append(plans, planObject)
+		}
+	}
+
+	plansTF, diags := types.ListValue(types.ObjectType{AttrTypes: planTypes}, plans)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Plans = plansTF
+	return nil
+}
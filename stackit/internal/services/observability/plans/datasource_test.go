@@ -0,0 +1,146 @@
+package plans
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *observability.PlansResponse
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_ok",
+			&observabilityPlansResponse,
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Plans: types.ListValueMust(types.ObjectType{AttrTypes: planTypes}, []attr.Value{
+					types.ObjectValueMust(planTypes, map[string]attr.Value{
+						"id":                        types.StringValue("id"),
+						"plan_id":                   types.StringValue("plan-id"),
+						"name":                      types.StringValue("Observability-Monitoring-Basic-EU01"),
+						"description":               types.StringValue("description"),
+						"is_free":                   types.BoolValue(false),
+						"is_public":                 types.BoolValue(true),
+						"amount":                    types.Float64Value(12.5),
+						"samples_per_scrape":        types.Int64Value(1000),
+						"target_number":             types.Int64Value(10),
+						"total_metric_samples":      types.Int64Value(50000),
+						"alert_rules":               types.Int64Value(100),
+						"alert_receivers":           types.Int64Value(5),
+						"alert_matchers":            types.Int64Value(10),
+						"logs_alert":                types.Int64Value(20),
+						"logs_storage":              types.Int64Value(7),
+						"traces_storage":            types.Int64Value(14),
+						"bucket_size":               types.Int64Value(20),
+						"grafana_global_dashboards": types.Int64Value(30),
+						"grafana_global_orgs":       types.Int64Value(1),
+						"grafana_global_sessions":   types.Int64Value(10),
+						"grafana_global_users":      types.Int64Value(10),
+					}),
+					types.ObjectValueMust(planTypes, map[string]attr.Value{
+						"id":                        types.StringValue("id-2"),
+						"plan_id":                   types.StringValue("plan-id-2"),
+						"name":                      types.StringNull(),
+						"description":               types.StringNull(),
+						"is_free":                   types.BoolNull(),
+						"is_public":                 types.BoolNull(),
+						"amount":                    types.Float64Null(),
+						"samples_per_scrape":        types.Int64Null(),
+						"target_number":             types.Int64Null(),
+						"total_metric_samples":      types.Int64Null(),
+						"alert_rules":               types.Int64Null(),
+						"alert_receivers":           types.Int64Null(),
+						"alert_matchers":            types.Int64Null(),
+						"logs_alert":                types.Int64Null(),
+						"logs_storage":              types.Int64Null(),
+						"traces_storage":            types.Int64Null(),
+						"bucket_size":               types.Int64Null(),
+						"grafana_global_dashboards": types.Int64Null(),
+						"grafana_global_orgs":       types.Int64Null(),
+						"grafana_global_sessions":   types.Int64Null(),
+						"grafana_global_users":      types.Int64Null(),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"no_plans",
+			&observability.PlansResponse{},
+			Model{
+				Id:        types.StringValue("pid"),
+				ProjectId: types.StringValue("pid"),
+				Plans:     types.ListValueMust(types.ObjectType{AttrTypes: planTypes}, []attr.Value{}),
+			},
+			true,
+		},
+		{
+			"response_nil_fail",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId: types.StringValue("pid"),
+			}
+			err := mapFields(tt.input, model)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(*model, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+var observabilityPlansResponse = observability.PlansResponse{
+	Plans: &[]observability.Plan{
+		{
+			Id:                      utils.Ptr("id"),
+			PlanId:                  utils.Ptr("plan-id"),
+			Name:                    utils.Ptr("Observability-Monitoring-Basic-EU01"),
+			Description:             utils.Ptr("description"),
+			IsFree:                  utils.Ptr(false),
+			IsPublic:                utils.Ptr(true),
+			Amount:                  utils.Ptr(12.5),
+			SamplesPerScrape:        utils.Ptr(int64(1000)),
+			TargetNumber:            utils.Ptr(int64(10)),
+			TotalMetricSamples:      utils.Ptr(int64(50000)),
+			AlertRules:              utils.Ptr(int64(100)),
+			AlertReceivers:          utils.Ptr(int64(5)),
+			AlertMatchers:           utils.Ptr(int64(10)),
+			LogsAlert:               utils.Ptr(int64(20)),
+			LogsStorage:             utils.Ptr(int64(7)),
+			TracesStorage:           utils.Ptr(int64(14)),
+			BucketSize:              utils.Ptr(int64(20)),
+			GrafanaGlobalDashboards: utils.Ptr(int64(30)),
+			GrafanaGlobalOrgs:       utils.Ptr(int64(1)),
+			GrafanaGlobalSessions:   utils.Ptr(int64(10)),
+			GrafanaGlobalUsers:      utils.Ptr(int64(10)),
+		},
+		{
+			Id:     utils.Ptr("id-2"),
+			PlanId: utils.Ptr("plan-id-2"),
+		},
+	},
+}
@@ -178,6 +178,131 @@ func (d *scrapeConfigDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 					},
 				},
 			},
+			"tls_config": tlsConfigDataSourceSchema("A TLS configuration block used when scraping the targets."),
+			"bearer_token": schema.StringAttribute{
+				Description: "Specifies the bearer token which is sent when scraping the targets.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"oauth2": oauth2DataSourceSchema("An OAuth2 client credentials block used when scraping the targets."),
+			"metrics_relabel_configs": schema.ListNestedAttribute{
+				Description: "List of metric relabel configurations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description: "Specifies the action to perform based on regex matching.",
+							Computed:    true,
+						},
+						"source_labels": schema.ListAttribute{
+							Description: "Specifies the source labels.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"separator": schema.StringAttribute{
+							Description: "Specifies the separator placed between concatenated source label values.",
+							Computed:    true,
+						},
+						"regex": schema.StringAttribute{
+							Description: "Specifies the regular expression against which the extracted value is matched.",
+							Computed:    true,
+						},
+						"modulus": schema.Int64Attribute{
+							Description: "Specifies the modulus to take of the hash of the source label values.",
+							Computed:    true,
+						},
+						"target_label": schema.StringAttribute{
+							Description: "Specifies the label to which the resulting value is written.",
+							Computed:    true,
+						},
+						"replacement": schema.StringAttribute{
+							Description: "Specifies the replacement value against which a regex replace is performed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"http_sd_configs": schema.ListNestedAttribute{
+				Description: "List of HTTP service discovery configurations.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "Specifies the URL from which the targets are fetched.",
+							Computed:    true,
+						},
+						"refresh_interval": schema.StringAttribute{
+							Description: "Specifies the refresh interval as duration string.",
+							Computed:    true,
+						},
+						"basic_auth": schema.SingleNestedAttribute{
+							Description: "A basic authentication block used when fetching the targets.",
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"username": schema.StringAttribute{
+									Description: "Specifies basic auth username.",
+									Computed:    true,
+								},
+								"password": schema.StringAttribute{
+									Description: "Specifies basic auth password.",
+									Computed:    true,
+									Sensitive:   true,
+								},
+							},
+						},
+						"oauth2":     oauth2DataSourceSchema("An OAuth2 client credentials block used when fetching the targets."),
+						"tls_config": tlsConfigDataSourceSchema("A TLS configuration block used when fetching the targets."),
+					},
+				},
+			},
+			"params": schema.MapAttribute{
+				Description: "Specifies additional HTTP URL parameters sent when scraping the targets.",
+				Computed:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+			},
+		},
+	}
+}
+
+// tlsConfigDataSourceSchema returns the data source schema of a TLS configuration block
+func tlsConfigDataSourceSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Specifies if the validation of the server certificate is disabled.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// oauth2DataSourceSchema returns the data source schema of an OAuth2 client credentials block
+func oauth2DataSourceSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Computed:    true,
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "Specifies the OAuth2 client ID.",
+				Computed:    true,
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "Specifies the OAuth2 client secret.",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"token_url": schema.StringAttribute{
+				Description: "Specifies the URL from which the token is fetched.",
+				Computed:    true,
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Specifies the scopes of the token request.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"tls_config": tlsConfigDataSourceSchema("A TLS configuration block used when fetching the token."),
 		},
 	}
 }
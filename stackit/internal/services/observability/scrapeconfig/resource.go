@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,7 +22,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
@@ -44,13 +47,20 @@ const (
 	DefaultScrapeTimeout            = "2m"
 	DefaultSampleLimit              = int64(5000)
 	DefaultSAML2EnableURLParameters = true
+	DefaultRelabelAction            = observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_REPLACE
+	DefaultRelabelRegex             = "(.*)"
+	DefaultRelabelSeparator         = ";"
+	DefaultRelabelReplacement       = "$1"
+	DefaultHTTPSDRefreshInterval    = "60s"
+	DefaultTLSInsecureSkipVerify    = false
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &scrapeConfigResource{}
-	_ resource.ResourceWithConfigure   = &scrapeConfigResource{}
-	_ resource.ResourceWithImportState = &scrapeConfigResource{}
+	_ resource.Resource                   = &scrapeConfigResource{}
+	_ resource.ResourceWithConfigure      = &scrapeConfigResource{}
+	_ resource.ResourceWithImportState    = &scrapeConfigResource{}
+	_ resource.ResourceWithValidateConfig = &scrapeConfigResource{}
 )
 
 type Model struct {
@@ -66,6 +76,12 @@ type Model struct {
 	SAML2          types.Object `tfsdk:"saml2"`
 	BasicAuth      types.Object `tfsdk:"basic_auth"`
 	Targets        types.List   `tfsdk:"targets"`
+	TLSConfig      types.Object `tfsdk:"tls_config"`
+	BearerToken    types.String `tfsdk:"bearer_token"`
+	OAuth2         types.Object `tfsdk:"oauth2"`
+	RelabelConfigs types.List   `tfsdk:"metrics_relabel_configs"`
+	HTTPSDConfigs  types.List   `tfsdk:"http_sd_configs"`
+	Params         types.Map    `tfsdk:"params"`
 }
 
 // Struct corresponding to Model.SAML2
@@ -102,6 +118,74 @@ var targetTypes = map[string]attr.Type{
 	"labels": types.MapType{ElemType: types.StringType},
 }
 
+// Struct corresponding to Model.TLSConfig
+type tlsConfigModel struct {
+	InsecureSkipVerify types.Bool `tfsdk:"insecure_skip_verify"`
+}
+
+// Types corresponding to tlsConfigModel
+var tlsConfigTypes = map[string]attr.Type{
+	"insecure_skip_verify": types.BoolType,
+}
+
+// Struct corresponding to Model.OAuth2
+type oauth2Model struct {
+	ClientId     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	TokenURL     types.String `tfsdk:"token_url"`
+	Scopes       types.List   `tfsdk:"scopes"`
+	TLSConfig    types.Object `tfsdk:"tls_config"`
+}
+
+// Types corresponding to oauth2Model
+var oauth2Types = map[string]attr.Type{
+	"client_id":     types.StringType,
+	"client_secret": types.StringType,
+	"token_url":     types.StringType,
+	"scopes":        types.ListType{ElemType: types.StringType},
+	"tls_config":    types.ObjectType{AttrTypes: tlsConfigTypes},
+}
+
+// Struct corresponding to Model.RelabelConfigs[i]
+type relabelConfigModel struct {
+	Action       types.String `tfsdk:"action"`
+	SourceLabels types.List   `tfsdk:"source_labels"`
+	Separator    types.String `tfsdk:"separator"`
+	Regex        types.String `tfsdk:"regex"`
+	Modulus      types.Int64  `tfsdk:"modulus"`
+	TargetLabel  types.String `tfsdk:"target_label"`
+	Replacement  types.String `tfsdk:"replacement"`
+}
+
+// Types corresponding to relabelConfigModel
+var relabelConfigTypes = map[string]attr.Type{
+	"action":        types.StringType,
+	"source_labels": types.ListType{ElemType: types.StringType},
+	"separator":     types.StringType,
+	"regex":         types.StringType,
+	"modulus":       types.Int64Type,
+	"target_label":  types.StringType,
+	"replacement":   types.StringType,
+}
+
+// Struct corresponding to Model.HTTPSDConfigs[i]
+type httpSDConfigModel struct {
+	URL             types.String `tfsdk:"url"`
+	RefreshInterval types.String `tfsdk:"refresh_interval"`
+	BasicAuth       types.Object `tfsdk:"basic_auth"`
+	OAuth2          types.Object `tfsdk:"oauth2"`
+	TLSConfig       types.Object `tfsdk:"tls_config"`
+}
+
+// Types corresponding to httpSDConfigModel
+var httpSDConfigTypes = map[string]attr.Type{
+	"url":              types.StringType,
+	"refresh_interval": types.StringType,
+	"basic_auth":       types.ObjectType{AttrTypes: basicAuthTypes},
+	"oauth2":           types.ObjectType{AttrTypes: oauth2Types},
+	"tls_config":       types.ObjectType{AttrTypes: tlsConfigTypes},
+}
+
 // NewScrapeConfigResource is a helper function to simplify the provider implementation.
 func NewScrapeConfigResource() resource.Resource {
 	return &scrapeConfigResource{}
@@ -291,6 +375,221 @@ func (r *scrapeConfigResource) Schema(_ context.Context, _ resource.SchemaReques
 					},
 				},
 			},
+			"tls_config": tlsConfigSchema("A TLS configuration block used when scraping the targets."),
+			"bearer_token": schema.StringAttribute{
+				Description: "Specifies a bearer token which is sent in the `Authorization` header when scraping the targets.",
+				Optional:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("basic_auth"),
+						path.MatchRoot("oauth2"),
+					),
+				},
+			},
+			"oauth2": oauth2Schema(
+				"An OAuth2 client credentials block used when scraping the targets. Changing this forces a new resource, since the API does not support updating it.",
+				[]validator.Object{
+					objectvalidator.ConflictsWith(path.MatchRoot("basic_auth")),
+				},
+			),
+			"metrics_relabel_configs": schema.ListNestedAttribute{
+				Description: "List of metric relabel configurations, applied to the scraped samples before they are ingested.",
+				Optional:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							Description: fmt.Sprintf("Specifies the action to perform based on regex matching. %s Defaults to `%s`.", utils.SupportedValuesDocumentation(relabelActions), DefaultRelabelAction),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(string(DefaultRelabelAction)),
+							Validators: []validator.String{
+								stringvalidator.OneOf(relabelActions...),
+							},
+						},
+						"source_labels": schema.ListAttribute{
+							Description: "Specifies the source labels. Their values are concatenated using the `separator` and matched against the `regex`.",
+							Optional:    true,
+							ElementType: types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+								listvalidator.ValueStringsAre(
+									stringvalidator.LengthBetween(1, 200),
+								),
+							},
+						},
+						"separator": schema.StringAttribute{
+							Description: fmt.Sprintf("Specifies the separator placed between concatenated source label values. Defaults to `%s`.", DefaultRelabelSeparator),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelSeparator),
+						},
+						"regex": schema.StringAttribute{
+							Description: fmt.Sprintf("Specifies the regular expression (RE2 syntax) against which the extracted value is matched. Defaults to `%s`.", DefaultRelabelRegex),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelRegex),
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"modulus": schema.Int64Attribute{
+							Description: "Specifies the modulus to take of the hash of the source label values. Required for the `hashmod` action.",
+							Optional:    true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"target_label": schema.StringAttribute{
+							Description: "Specifies the label to which the resulting value is written. Required for the `replace` and `hashmod` actions.",
+							Optional:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+						"replacement": schema.StringAttribute{
+							Description: fmt.Sprintf("Specifies the replacement value against which a regex replace is performed if the regex matches. Defaults to `%s`.", DefaultRelabelReplacement),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultRelabelReplacement),
+						},
+					},
+				},
+			},
+			"http_sd_configs": schema.ListNestedAttribute{
+				Description: "List of HTTP service discovery configurations. The targets are fetched from the given URLs in addition to the static `targets`. Changing this forces a new resource, since the API does not support updating it.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Description: "Specifies the URL from which the targets are fetched.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 500),
+							},
+						},
+						"refresh_interval": schema.StringAttribute{
+							Description: fmt.Sprintf("Specifies the refresh interval as duration string. Defaults to `%s`.", DefaultHTTPSDRefreshInterval),
+							Optional:    true,
+							Computed:    true,
+							Default:     stringdefault.StaticString(DefaultHTTPSDRefreshInterval),
+							Validators: []validator.String{
+								validate.ValidDurationString(),
+							},
+						},
+						"basic_auth": schema.SingleNestedAttribute{
+							Description: "A basic authentication block used when fetching the targets.",
+							Optional:    true,
+							Attributes: map[string]schema.Attribute{
+								"username": schema.StringAttribute{
+									Description: "Specifies basic auth username.",
+									Required:    true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 200),
+									},
+								},
+								"password": schema.StringAttribute{
+									Description: "Specifies basic auth password.",
+									Required:    true,
+									Sensitive:   true,
+									Validators: []validator.String{
+										stringvalidator.LengthBetween(1, 200),
+									},
+								},
+							},
+						},
+						"oauth2": oauth2Schema(
+							"An OAuth2 client credentials block used when fetching the targets.",
+							[]validator.Object{
+								objectvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("basic_auth")),
+							},
+						),
+						"tls_config": tlsConfigSchema("A TLS configuration block used when fetching the targets."),
+					},
+				},
+			},
+			"params": schema.MapAttribute{
+				Description: "Specifies additional HTTP URL parameters sent when scraping the targets. The `saml2` parameter is managed by the `saml2` block.",
+				Optional:    true,
+				ElementType: types.ListType{ElemType: types.StringType},
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.LengthBetween(1, 200),
+						stringvalidator.NoneOf("saml2"),
+					),
+				},
+			},
+		},
+	}
+}
+
+// tlsConfigSchema returns the schema of a TLS configuration block
+func tlsConfigSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Disables the validation of the server certificate. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(DefaultTLSInsecureSkipVerify),
+			},
+		},
+	}
+}
+
+// oauth2Schema returns the schema of an OAuth2 client credentials block
+func oauth2Schema(description string, validators []validator.Object) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Validators:  validators,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.RequiresReplace(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"client_id": schema.StringAttribute{
+				Description: "Specifies the OAuth2 client ID.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Description: "Specifies the OAuth2 client secret.",
+				Required:    true,
+				Sensitive:   true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"token_url": schema.StringAttribute{
+				Description: "Specifies the URL from which the token is fetched.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			"scopes": schema.ListAttribute{
+				Description: "Specifies the scopes of the token request.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"tls_config": tlsConfigSchema("A TLS configuration block used when fetching the token."),
 		},
 	}
 }
@@ -347,9 +646,10 @@ func (r *scrapeConfigResource) Create(ctx context.Context, req resource.CreateRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.CreateScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "scrape config")
+	_, err = wait.CreateScrapeConfigWaitHandler(waitCtx, r.client, instanceId, scName, projectId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", fmt.Sprintf("Scrape config creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating scrape config", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Scrape config creation waiting: %v", err)))
 		return
 	}
 	got, err := r.client.GetScrapeConfig(ctx, instanceId, scName, projectId).Execute()
@@ -502,9 +802,10 @@ func (r *scrapeConfigResource) Delete(ctx context.Context, req resource.DeleteRe
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteScrapeConfigWaitHandler(ctx, r.client, instanceId, scName, projectId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "scrape config")
+	_, err = wait.DeleteScrapeConfigWaitHandler(waitCtx, r.client, instanceId, scName, projectId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", fmt.Sprintf("Scrape config deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting scrape config", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Scrape config deletion waiting: %v", err)))
 		return
 	}
 
@@ -566,6 +867,27 @@ func mapFields(ctx context.Context, sc *observability.Job, model *Model) error {
 	if err != nil {
 		return fmt.Errorf("map targets: %w", err)
 	}
+	model.BearerToken = types.StringPointerValue(sc.BearerToken)
+	model.TLSConfig, err = mapTLSConfig(sc.TlsConfig, model.TLSConfig)
+	if err != nil {
+		return fmt.Errorf("map tls config: %w", err)
+	}
+	model.OAuth2, err = mapOAuth2(ctx, sc.Oauth2, model.OAuth2)
+	if err != nil {
+		return fmt.Errorf("map oauth2: %w", err)
+	}
+	err = mapRelabelConfigs(sc, model)
+	if err != nil {
+		return fmt.Errorf("map metrics relabel configs: %w", err)
+	}
+	err = mapHTTPSDConfigs(ctx, sc, model)
+	if err != nil {
+		return fmt.Errorf("map http sd configs: %w", err)
+	}
+	err = mapParams(sc, model)
+	if err != nil {
+		return fmt.Errorf("map params: %w", err)
+	}
 	return nil
 }
 
@@ -677,6 +999,199 @@ func mapTargets(ctx context.Context, sc *observability.Job, model *Model) error
 	return nil
 }
 
+// mapTLSConfig maps a TLS config of the API to Terraform. A TLS config without settings is kept null if it is not configured.
+func mapTLSConfig(tlsConfig *observability.TLSConfig, current types.Object) (types.Object, error) {
+	if tlsConfig == nil || (current.IsNull() && tlsConfig.GetInsecureSkipVerify() == DefaultTLSInsecureSkipVerify) {
+		return types.ObjectNull(tlsConfigTypes), nil
+	}
+	tlsConfigTF, diags := types.ObjectValue(tlsConfigTypes, map[string]attr.Value{
+		"insecure_skip_verify": types.BoolValue(tlsConfig.GetInsecureSkipVerify()),
+	})
+	if diags.HasError() {
+		return types.ObjectNull(tlsConfigTypes), core.DiagsToError(diags)
+	}
+	return tlsConfigTF, nil
+}
+
+func mapOAuth2(ctx context.Context, oauth2 *observability.OAuth2, current types.Object) (types.Object, error) {
+	if oauth2 == nil {
+		return types.ObjectNull(oauth2Types), nil
+	}
+
+	currentModel := oauth2Model{}
+	if !current.IsNull() && !current.IsUnknown() {
+		diags := current.As(ctx, &currentModel, basetypes.ObjectAsOptions{})
+		if diags.HasError() {
+			return types.ObjectNull(oauth2Types), core.DiagsToError(diags)
+		}
+	} else {
+		currentModel.TLSConfig = types.ObjectNull(tlsConfigTypes)
+	}
+
+	scopes := types.ListNull(types.StringType)
+	if oauth2.Scopes != nil && len(*oauth2.Scopes) > 0 {
+		scopesTF, diags := types.ListValueFrom(ctx, types.StringType, *oauth2.Scopes)
+		if diags.HasError() {
+			return types.ObjectNull(oauth2Types), core.DiagsToError(diags)
+		}
+		scopes = scopesTF
+	}
+	tlsConfig, err := mapTLSConfig(oauth2.TlsConfig, currentModel.TLSConfig)
+	if err != nil {
+		return types.ObjectNull(oauth2Types), fmt.Errorf("map tls config: %w", err)
+	}
+
+	oauth2TF, diags := types.ObjectValue(oauth2Types, map[string]attr.Value{
+		"client_id":     types.StringPointerValue(oauth2.ClientId),
+		"client_secret": types.StringPointerValue(oauth2.ClientSecret),
+		"token_url":     types.StringPointerValue(oauth2.TokenUrl),
+		"scopes":        scopes,
+		"tls_config":    tlsConfig,
+	})
+	if diags.HasError() {
+		return types.ObjectNull(oauth2Types), core.DiagsToError(diags)
+	}
+	return oauth2TF, nil
+}
+
+func mapRelabelConfigs(sc *observability.Job, model *Model) error {
+	if sc.MetricsRelabelConfigs == nil || len(*sc.MetricsRelabelConfigs) == 0 {
+		model.RelabelConfigs = types.ListNull(types.ObjectType{AttrTypes: relabelConfigTypes})
+		return nil
+	}
+
+	relabelConfigs := []attr.Value{}
+	for _, rc := range *sc.MetricsRelabelConfigs {
+		sourceLabels := types.ListNull(types.StringType)
+		if rc.SourceLabels != nil && len(*rc.SourceLabels) > 0 {
+			labels := []attr.Value{}
+			for _, l := range *rc.SourceLabels {
+				labels = append(labels, types.StringValue(l))
+			}
+			sourceLabels = types.ListValueMust(types.StringType, labels)
+		}
+
+		var action *string
+		if rc.Action != nil {
+			action = sdkUtils.Ptr(string(*rc.Action))
+		}
+
+		relabelConfigTF, diags := types.ObjectValue(relabelConfigTypes, map[string]attr.Value{
+			"action":        types.StringPointerValue(action),
+			"source_labels": sourceLabels,
+			"separator":     types.StringPointerValue(rc.Separator),
+			"regex":         types.StringPointerValue(rc.Regex),
+			"modulus":       types.Int64PointerValue(rc.Modulus),
+			"target_label":  types.StringPointerValue(rc.TargetLabel),
+			"replacement":   types.StringPointerValue(rc.Replacement),
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+		relabelConfigs = append(relabelConfigs, relabelConfigTF)
+	}
+
+	relabelConfigsTF, diags := types.ListValue(types.ObjectType{AttrTypes: relabelConfigTypes}, relabelConfigs)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.RelabelConfigs = relabelConfigsTF
+	return nil
+}
+
+func mapHTTPSDConfigs(ctx context.Context, sc *observability.Job, model *Model) error {
+	if sc.HttpSdConfigs == nil || len(*sc.HttpSdConfigs) == 0 {
+		model.HTTPSDConfigs = types.ListNull(types.ObjectType{AttrTypes: httpSDConfigTypes})
+		return nil
+	}
+
+	currentModels := []httpSDConfigModel{}
+	if !model.HTTPSDConfigs.IsNull() && !model.HTTPSDConfigs.IsUnknown() {
+		diags := model.HTTPSDConfigs.ElementsAs(ctx, &currentModels, false)
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+	}
+
+	httpSDConfigs := []attr.Value{}
+	for i, sd := range *sc.HttpSdConfigs {
+		current := httpSDConfigModel{
+			OAuth2:    types.ObjectNull(oauth2Types),
+			TLSConfig: types.ObjectNull(tlsConfigTypes),
+		}
+		if i < len(currentModels) {
+			current = currentModels[i]
+		}
+
+		basicAuth := types.ObjectNull(basicAuthTypes)
+		if sd.BasicAuth != nil {
+			basicAuthTF, diags := types.ObjectValue(basicAuthTypes, map[string]attr.Value{
+				"username": types.StringPointerValue(sd.BasicAuth.Username),
+				"password": types.StringPointerValue(sd.BasicAuth.Password),
+			})
+			if diags.HasError() {
+				return core.DiagsToError(diags)
+			}
+			basicAuth = basicAuthTF
+		}
+		oauth2, err := mapOAuth2(ctx, sd.Oauth2, current.OAuth2)
+		if err != nil {
+			return fmt.Errorf("map oauth2: %w", err)
+		}
+		tlsConfig, err := mapTLSConfig(sd.TlsConfig, current.TLSConfig)
+		if err != nil {
+			return fmt.Errorf("map tls config: %w", err)
+		}
+
+		httpSDConfigTF, diags := types.ObjectValue(httpSDConfigTypes, map[string]attr.Value{
+			"url":              types.StringPointerValue(sd.Url),
+			"refresh_interval": types.StringPointerValue(sd.RefreshInterval),
+			"basic_auth":       basicAuth,
+			"oauth2":           oauth2,
+			"tls_config":       tlsConfig,
+		})
+		if diags.HasError() {
+			return core.DiagsToError(diags)
+		}
+		httpSDConfigs = append(httpSDConfigs, httpSDConfigTF)
+	}
+
+	httpSDConfigsTF, diags := types.ListValue(types.ObjectType{AttrTypes: httpSDConfigTypes}, httpSDConfigs)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.HTTPSDConfigs = httpSDConfigsTF
+	return nil
+}
+
+// mapParams maps the URL parameters of the API to Terraform. The saml2 parameter is excluded, it is mapped by mapSAML2.
+func mapParams(sc *observability.Job, model *Model) error {
+	params := map[string]attr.Value{}
+	if sc.Params != nil {
+		for k, v := range *sc.Params {
+			if k == "saml2" {
+				continue
+			}
+			values := []attr.Value{}
+			for _, value := range v {
+				values = append(values, types.StringValue(value))
+			}
+			params[k] = types.ListValueMust(types.StringType, values)
+		}
+	}
+	if len(params) == 0 && model.Params.IsNull() {
+		model.Params = types.MapNull(types.ListType{ElemType: types.StringType})
+		return nil
+	}
+
+	paramsTF, diags := types.MapValue(types.ListType{ElemType: types.StringType}, params)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.Params = paramsTF
+	return nil
+}
+
 func toCreatePayload(ctx context.Context, model *Model, saml2Model *saml2Model, basicAuthModel *basicAuthModel, targetsModel []targetModel) (*observability.CreateScrapeConfigPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -733,6 +1248,33 @@ func toCreatePayload(ctx context.Context, model *Model, saml2Model *saml2Model,
 	}
 	sc.StaticConfigs = &t
 
+	sc.BearerToken = conversion.StringValueToPointer(model.BearerToken)
+	tlsConfig, err := toTLSConfigPayload(ctx, model.TLSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("converting tls config: %w", err)
+	}
+	sc.TlsConfig = tlsConfig
+	oauth2, err := toOAuth2Payload(ctx, model.OAuth2)
+	if err != nil {
+		return nil, fmt.Errorf("converting oauth2: %w", err)
+	}
+	sc.Oauth2 = oauth2
+	relabelConfigs, err := toRelabelConfigsPayload(ctx, model.RelabelConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("converting metrics relabel configs: %w", err)
+	}
+	sc.MetricsRelabelConfigs = relabelConfigs
+	httpSDConfigs, err := toHTTPSDConfigsPayload(ctx, model.HTTPSDConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("converting http sd configs: %w", err)
+	}
+	sc.HttpSdConfigs = httpSDConfigs
+	params, err := toParamsPayload(ctx, model.Params, sc.Params)
+	if err != nil {
+		return nil, fmt.Errorf("converting params: %w", err)
+	}
+	sc.Params = params
+
 	return &sc, nil
 }
 
@@ -822,6 +1364,23 @@ func toUpdatePayload(ctx context.Context, model *Model, saml2Model *saml2Model,
 	}
 	sc.StaticConfigs = &t
 
+	sc.BearerToken = conversion.StringValueToPointer(model.BearerToken)
+	tlsConfig, err := toTLSConfigPayload(ctx, model.TLSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("converting tls config: %w", err)
+	}
+	sc.TlsConfig = tlsConfig
+	relabelConfigs, err := toRelabelConfigsPayload(ctx, model.RelabelConfigs)
+	if err != nil {
+		return nil, fmt.Errorf("converting metrics relabel configs: %w", err)
+	}
+	sc.MetricsRelabelConfigs = relabelConfigs
+	params, err := toParamsPayload(ctx, model.Params, sc.Params)
+	if err != nil {
+		return nil, fmt.Errorf("converting params: %w", err)
+	}
+	sc.Params = params
+
 	return &sc, nil
 }
 
@@ -842,3 +1401,141 @@ func setDefaultsUpdateScrapeConfig(sc *observability.UpdateScrapeConfigPayload,
 		sc.SampleLimit = sdkUtils.Ptr(float64(DefaultSampleLimit))
 	}
 }
+
+func toTLSConfigPayload(ctx context.Context, tlsConfig types.Object) (*observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2TlsConfig, error) {
+	if tlsConfig.IsNull() || tlsConfig.IsUnknown() {
+		return nil, nil
+	}
+	tlsConfigModel := tlsConfigModel{}
+	diags := tlsConfig.As(ctx, &tlsConfigModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+	return &observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2TlsConfig{
+		InsecureSkipVerify: conversion.BoolValueToPointer(tlsConfigModel.InsecureSkipVerify),
+	}, nil
+}
+
+func toOAuth2Payload(ctx context.Context, oauth2 types.Object) (*observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2, error) {
+	if oauth2.IsNull() || oauth2.IsUnknown() {
+		return nil, nil
+	}
+	oauth2Model := oauth2Model{}
+	diags := oauth2.As(ctx, &oauth2Model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	scopes, err := conversion.StringListToPointer(oauth2Model.Scopes)
+	if err != nil {
+		return nil, fmt.Errorf("converting scopes: %w", err)
+	}
+	tlsConfig, err := toTLSConfigPayload(ctx, oauth2Model.TLSConfig)
+	if err != nil {
+		return nil, fmt.Errorf("converting tls config: %w", err)
+	}
+	return &observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2{
+		ClientId:     conversion.StringValueToPointer(oauth2Model.ClientId),
+		ClientSecret: conversion.StringValueToPointer(oauth2Model.ClientSecret),
+		TokenUrl:     conversion.StringValueToPointer(oauth2Model.TokenURL),
+		Scopes:       scopes,
+		TlsConfig:    tlsConfig,
+	}, nil
+}
+
+func toRelabelConfigsPayload(ctx context.Context, relabelConfigs types.List) (*[]observability.CreateScrapeConfigPayloadMetricsRelabelConfigsInner, error) {
+	if relabelConfigs.IsNull() || relabelConfigs.IsUnknown() {
+		return nil, nil
+	}
+	relabelConfigsModel := []relabelConfigModel{}
+	diags := relabelConfigs.ElementsAs(ctx, &relabelConfigsModel, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	payload := make([]observability.CreateScrapeConfigPayloadMetricsRelabelConfigsInner, len(relabelConfigsModel))
+	for i, rc := range relabelConfigsModel {
+		sourceLabels, err := conversion.StringListToPointer(rc.SourceLabels)
+		if err != nil {
+			return nil, fmt.Errorf("converting source labels: %w", err)
+		}
+		var modulus *float64
+		if !rc.Modulus.IsNull() && !rc.Modulus.IsUnknown() {
+			modulus = sdkUtils.Ptr(float64(rc.Modulus.ValueInt64()))
+		}
+		payload[i] = observability.CreateScrapeConfigPayloadMetricsRelabelConfigsInner{
+			Action:       observability.CreateScrapeConfigPayloadMetricsRelabelConfigsInnerGetActionAttributeType(conversion.StringValueToPointer(rc.Action)),
+			SourceLabels: sourceLabels,
+			Separator:    conversion.StringValueToPointer(rc.Separator),
+			Regex:        conversion.StringValueToPointer(rc.Regex),
+			Modulus:      modulus,
+			TargetLabel:  conversion.StringValueToPointer(rc.TargetLabel),
+			Replacement:  conversion.StringValueToPointer(rc.Replacement),
+		}
+	}
+	return &payload, nil
+}
+
+func toHTTPSDConfigsPayload(ctx context.Context, httpSDConfigs types.List) (*[]observability.CreateScrapeConfigPayloadHttpSdConfigsInner, error) {
+	if httpSDConfigs.IsNull() || httpSDConfigs.IsUnknown() {
+		return nil, nil
+	}
+	httpSDConfigsModel := []httpSDConfigModel{}
+	diags := httpSDConfigs.ElementsAs(ctx, &httpSDConfigsModel, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	payload := make([]observability.CreateScrapeConfigPayloadHttpSdConfigsInner, len(httpSDConfigsModel))
+	for i, sd := range httpSDConfigsModel {
+		var basicAuth *observability.CreateScrapeConfigPayloadBasicAuth
+		if !sd.BasicAuth.IsNull() && !sd.BasicAuth.IsUnknown() {
+			basicAuthModel := basicAuthModel{}
+			diags := sd.BasicAuth.As(ctx, &basicAuthModel, basetypes.ObjectAsOptions{})
+			if diags.HasError() {
+				return nil, core.DiagsToError(diags)
+			}
+			basicAuth = &observability.CreateScrapeConfigPayloadBasicAuth{
+				Username: conversion.StringValueToPointer(basicAuthModel.Username),
+				Password: conversion.StringValueToPointer(basicAuthModel.Password),
+			}
+		}
+		oauth2, err := toOAuth2Payload(ctx, sd.OAuth2)
+		if err != nil {
+			return nil, fmt.Errorf("converting oauth2: %w", err)
+		}
+		tlsConfig, err := toTLSConfigPayload(ctx, sd.TLSConfig)
+		if err != nil {
+			return nil, fmt.Errorf("converting tls config: %w", err)
+		}
+		payload[i] = observability.CreateScrapeConfigPayloadHttpSdConfigsInner{
+			Url:             conversion.StringValueToPointer(sd.URL),
+			RefreshInterval: conversion.StringValueToPointer(sd.RefreshInterval),
+			BasicAuth:       basicAuth,
+			Oauth2:          oauth2,
+			TlsConfig:       tlsConfig,
+		}
+	}
+	return &payload, nil
+}
+
+// toParamsPayload adds the configured URL parameters to the given params, which already hold the saml2 parameter
+func toParamsPayload(ctx context.Context, params types.Map, current *map[string]interface{}) (*map[string]interface{}, error) {
+	if params.IsNull() || params.IsUnknown() {
+		return current, nil
+	}
+	paramsModel := map[string][]string{}
+	diags := params.ElementsAs(ctx, &paramsModel, false)
+	if diags.HasError() {
+		return nil, core.DiagsToError(diags)
+	}
+
+	m := map[string]interface{}{}
+	if current != nil {
+		m = *current
+	}
+	for k, v := range paramsModel {
+		m[k] = v
+	}
+	return &m, nil
+}
@@ -35,6 +35,11 @@ func TestMapFields(t *testing.T) {
 				SAML2:          types.ObjectNull(saml2Types),
 				BasicAuth:      types.ObjectNull(basicAuthTypes),
 				Targets:        types.ListNull(types.ObjectType{AttrTypes: targetTypes}),
+				TLSConfig:      types.ObjectNull(tlsConfigTypes),
+				OAuth2:         types.ObjectNull(oauth2Types),
+				RelabelConfigs: types.ListNull(types.ObjectType{AttrTypes: relabelConfigTypes}),
+				HTTPSDConfigs:  types.ListNull(types.ObjectType{AttrTypes: httpSDConfigTypes}),
+				Params:         types.MapNull(types.ListType{ElemType: types.StringType}),
 			},
 			true,
 		},
@@ -103,6 +108,117 @@ func TestMapFields(t *testing.T) {
 						"labels": types.MapNull(types.StringType),
 					}),
 				}),
+				TLSConfig:      types.ObjectNull(tlsConfigTypes),
+				OAuth2:         types.ObjectNull(oauth2Types),
+				RelabelConfigs: types.ListNull(types.ObjectType{AttrTypes: relabelConfigTypes}),
+				HTTPSDConfigs:  types.ListNull(types.ObjectType{AttrTypes: httpSDConfigTypes}),
+				Params: types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{
+					"x": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("y"), types.StringValue("z")}),
+				}),
+			},
+			isValid: true,
+		},
+		{
+			description: "advanced_ok",
+			input: &observability.Job{
+				JobName:     utils.Ptr("name"),
+				BearerToken: utils.Ptr("token"),
+				TlsConfig:   &observability.TLSConfig{InsecureSkipVerify: utils.Ptr(true)},
+				Oauth2: &observability.OAuth2{
+					ClientId:     utils.Ptr("id"),
+					ClientSecret: utils.Ptr("secret"),
+					TokenUrl:     utils.Ptr("https://token"),
+					Scopes:       &[]string{"s1", "s2"},
+					TlsConfig:    &observability.TLSConfig{InsecureSkipVerify: utils.Ptr(false)},
+				},
+				MetricsRelabelConfigs: &[]observability.MetricsRelabelConfig{
+					{
+						Action:       observability.METRICSRELABELCONFIGACTION_HASHMOD.Ptr(),
+						SourceLabels: &[]string{"l1", "l2"},
+						Separator:    utils.Ptr(";"),
+						Regex:        utils.Ptr("(.*)"),
+						Modulus:      utils.Ptr(int64(4)),
+						TargetLabel:  utils.Ptr("shard"),
+						Replacement:  utils.Ptr("$1"),
+					},
+					{
+						Action: observability.METRICSRELABELCONFIGACTION_LABELDROP.Ptr(),
+						Regex:  utils.Ptr("tmp_.*"),
+					},
+				},
+				HttpSdConfigs: &[]observability.HTTPServiceSD{
+					{
+						Url:             utils.Ptr("https://sd"),
+						RefreshInterval: utils.Ptr("30s"),
+						BasicAuth: &observability.BasicAuth{
+							Username: utils.Ptr("u"),
+							Password: utils.Ptr("p"),
+						},
+						TlsConfig: &observability.TLSConfig{InsecureSkipVerify: utils.Ptr(true)},
+					},
+				},
+				Params: &map[string][]string{"saml2": {"enabled"}},
+			},
+			expected: Model{
+				Id:             types.StringValue("pid,iid,name"),
+				ProjectId:      types.StringValue("pid"),
+				InstanceId:     types.StringValue("iid"),
+				Name:           types.StringValue("name"),
+				MetricsPath:    types.StringNull(),
+				Scheme:         types.StringValue(""),
+				ScrapeInterval: types.StringNull(),
+				ScrapeTimeout:  types.StringNull(),
+				SAML2: types.ObjectValueMust(saml2Types, map[string]attr.Value{
+					"enable_url_parameters": types.BoolValue(true),
+				}),
+				BasicAuth:   types.ObjectNull(basicAuthTypes),
+				Targets:     types.ListNull(types.ObjectType{AttrTypes: targetTypes}),
+				BearerToken: types.StringValue("token"),
+				TLSConfig: types.ObjectValueMust(tlsConfigTypes, map[string]attr.Value{
+					"insecure_skip_verify": types.BoolValue(true),
+				}),
+				OAuth2: types.ObjectValueMust(oauth2Types, map[string]attr.Value{
+					"client_id":     types.StringValue("id"),
+					"client_secret": types.StringValue("secret"),
+					"token_url":     types.StringValue("https://token"),
+					"scopes":        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("s1"), types.StringValue("s2")}),
+					"tls_config":    types.ObjectNull(tlsConfigTypes),
+				}),
+				RelabelConfigs: types.ListValueMust(types.ObjectType{AttrTypes: relabelConfigTypes}, []attr.Value{
+					types.ObjectValueMust(relabelConfigTypes, map[string]attr.Value{
+						"action":        types.StringValue("hashmod"),
+						"source_labels": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("l1"), types.StringValue("l2")}),
+						"separator":     types.StringValue(";"),
+						"regex":         types.StringValue("(.*)"),
+						"modulus":       types.Int64Value(4),
+						"target_label":  types.StringValue("shard"),
+						"replacement":   types.StringValue("$1"),
+					}),
+					types.ObjectValueMust(relabelConfigTypes, map[string]attr.Value{
+						"action":        types.StringValue("labeldrop"),
+						"source_labels": types.ListNull(types.StringType),
+						"separator":     types.StringNull(),
+						"regex":         types.StringValue("tmp_.*"),
+						"modulus":       types.Int64Null(),
+						"target_label":  types.StringNull(),
+						"replacement":   types.StringNull(),
+					}),
+				}),
+				HTTPSDConfigs: types.ListValueMust(types.ObjectType{AttrTypes: httpSDConfigTypes}, []attr.Value{
+					types.ObjectValueMust(httpSDConfigTypes, map[string]attr.Value{
+						"url":              types.StringValue("https://sd"),
+						"refresh_interval": types.StringValue("30s"),
+						"basic_auth": types.ObjectValueMust(basicAuthTypes, map[string]attr.Value{
+							"username": types.StringValue("u"),
+							"password": types.StringValue("p"),
+						}),
+						"oauth2": types.ObjectNull(oauth2Types),
+						"tls_config": types.ObjectValueMust(tlsConfigTypes, map[string]attr.Value{
+							"insecure_skip_verify": types.BoolValue(true),
+						}),
+					}),
+				}),
+				Params: types.MapNull(types.ListType{ElemType: types.StringType}),
 			},
 			isValid: true,
 		},
@@ -297,6 +413,118 @@ func TestToCreatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ok - with tls, oauth2, relabel configs, http sd configs and params",
+			&Model{
+				MetricsPath: types.StringValue("/metrics"),
+				Name:        types.StringValue("Name"),
+				TLSConfig: types.ObjectValueMust(tlsConfigTypes, map[string]attr.Value{
+					"insecure_skip_verify": types.BoolValue(true),
+				}),
+				OAuth2: types.ObjectValueMust(oauth2Types, map[string]attr.Value{
+					"client_id":     types.StringValue("id"),
+					"client_secret": types.StringValue("secret"),
+					"token_url":     types.StringValue("https://token"),
+					"scopes":        types.ListValueMust(types.StringType, []attr.Value{types.StringValue("s1")}),
+					"tls_config":    types.ObjectNull(tlsConfigTypes),
+				}),
+				RelabelConfigs: types.ListValueMust(types.ObjectType{AttrTypes: relabelConfigTypes}, []attr.Value{
+					types.ObjectValueMust(relabelConfigTypes, map[string]attr.Value{
+						"action":        types.StringValue("hashmod"),
+						"source_labels": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("l1")}),
+						"separator":     types.StringValue(";"),
+						"regex":         types.StringValue("(.*)"),
+						"modulus":       types.Int64Value(4),
+						"target_label":  types.StringValue("shard"),
+						"replacement":   types.StringValue("$1"),
+					}),
+				}),
+				HTTPSDConfigs: types.ListValueMust(types.ObjectType{AttrTypes: httpSDConfigTypes}, []attr.Value{
+					types.ObjectValueMust(httpSDConfigTypes, map[string]attr.Value{
+						"url":              types.StringValue("https://sd"),
+						"refresh_interval": types.StringValue("60s"),
+						"basic_auth": types.ObjectValueMust(basicAuthTypes, map[string]attr.Value{
+							"username": types.StringValue("u"),
+							"password": types.StringValue("p"),
+						}),
+						"oauth2":     types.ObjectNull(oauth2Types),
+						"tls_config": types.ObjectNull(tlsConfigTypes),
+					}),
+				}),
+				Params: types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{
+					"module": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("http_2xx")}),
+				}),
+			},
+			&saml2Model{},
+			&basicAuthModel{},
+			[]targetModel{},
+			&observability.CreateScrapeConfigPayload{
+				MetricsPath: utils.Ptr("/metrics"),
+				JobName:     utils.Ptr("Name"),
+				TlsConfig: &observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2TlsConfig{
+					InsecureSkipVerify: utils.Ptr(true),
+				},
+				Oauth2: &observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2{
+					ClientId:     utils.Ptr("id"),
+					ClientSecret: utils.Ptr("secret"),
+					TokenUrl:     utils.Ptr("https://token"),
+					Scopes:       &[]string{"s1"},
+				},
+				MetricsRelabelConfigs: &[]observability.CreateScrapeConfigPayloadMetricsRelabelConfigsInner{
+					{
+						Action:       observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_HASHMOD.Ptr(),
+						SourceLabels: &[]string{"l1"},
+						Separator:    utils.Ptr(";"),
+						Regex:        utils.Ptr("(.*)"),
+						Modulus:      utils.Ptr(float64(4)),
+						TargetLabel:  utils.Ptr("shard"),
+						Replacement:  utils.Ptr("$1"),
+					},
+				},
+				HttpSdConfigs: &[]observability.CreateScrapeConfigPayloadHttpSdConfigsInner{
+					{
+						Url:             utils.Ptr("https://sd"),
+						RefreshInterval: utils.Ptr("60s"),
+						BasicAuth: &observability.CreateScrapeConfigPayloadBasicAuth{
+							Username: utils.Ptr("u"),
+							Password: utils.Ptr("p"),
+						},
+					},
+				},
+				Params: &map[string]any{"saml2": []string{"enabled"}, "module": []string{"http_2xx"}},
+				// Defaults
+				Scheme:         observability.CREATESCRAPECONFIGPAYLOADSCHEME_HTTP.Ptr(),
+				ScrapeInterval: utils.Ptr("5m"),
+				ScrapeTimeout:  utils.Ptr("2m"),
+				SampleLimit:    utils.Ptr(float64(5000)),
+				StaticConfigs:  &[]observability.CreateScrapeConfigPayloadStaticConfigsInner{},
+			},
+			true,
+		},
+		{
+			"ok - with bearer token",
+			&Model{
+				MetricsPath: types.StringValue("/metrics"),
+				Name:        types.StringValue("Name"),
+				BearerToken: types.StringValue("token"),
+			},
+			&saml2Model{},
+			&basicAuthModel{},
+			[]targetModel{},
+			&observability.CreateScrapeConfigPayload{
+				MetricsPath: utils.Ptr("/metrics"),
+				JobName:     utils.Ptr("Name"),
+				BearerToken: utils.Ptr("token"),
+				// Defaults
+				Scheme:         observability.CREATESCRAPECONFIGPAYLOADSCHEME_HTTP.Ptr(),
+				ScrapeInterval: utils.Ptr("5m"),
+				ScrapeTimeout:  utils.Ptr("2m"),
+				SampleLimit:    utils.Ptr(float64(5000)),
+				StaticConfigs:  &[]observability.CreateScrapeConfigPayloadStaticConfigsInner{},
+				Params:         &map[string]any{"saml2": []string{"enabled"}},
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
@@ -474,6 +702,59 @@ func TestToUpdatePayload(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"ok - with tls, bearer token, relabel configs and params",
+			&Model{
+				MetricsPath: types.StringValue("/metrics"),
+				BearerToken: types.StringValue("token"),
+				TLSConfig: types.ObjectValueMust(tlsConfigTypes, map[string]attr.Value{
+					"insecure_skip_verify": types.BoolValue(false),
+				}),
+				RelabelConfigs: types.ListValueMust(types.ObjectType{AttrTypes: relabelConfigTypes}, []attr.Value{
+					types.ObjectValueMust(relabelConfigTypes, map[string]attr.Value{
+						"action":        types.StringValue("drop"),
+						"source_labels": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("__name__")}),
+						"separator":     types.StringValue(";"),
+						"regex":         types.StringValue("go_.*"),
+						"modulus":       types.Int64Null(),
+						"target_label":  types.StringNull(),
+						"replacement":   types.StringValue("$1"),
+					}),
+				}),
+				Params: types.MapValueMust(types.ListType{ElemType: types.StringType}, map[string]attr.Value{
+					"module": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("http_2xx")}),
+				}),
+			},
+			&saml2Model{
+				EnableURLParameters: types.BoolValue(false),
+			},
+			&basicAuthModel{},
+			[]targetModel{},
+			&observability.UpdateScrapeConfigPayload{
+				MetricsPath: utils.Ptr("/metrics"),
+				BearerToken: utils.Ptr("token"),
+				TlsConfig: &observability.CreateScrapeConfigPayloadHttpSdConfigsInnerOauth2TlsConfig{
+					InsecureSkipVerify: utils.Ptr(false),
+				},
+				MetricsRelabelConfigs: &[]observability.CreateScrapeConfigPayloadMetricsRelabelConfigsInner{
+					{
+						Action:       observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_DROP.Ptr(),
+						SourceLabels: &[]string{"__name__"},
+						Separator:    utils.Ptr(";"),
+						Regex:        utils.Ptr("go_.*"),
+						Replacement:  utils.Ptr("$1"),
+					},
+				},
+				Params: &map[string]any{"saml2": []string{"disabled"}, "module": []string{"http_2xx"}},
+				// Defaults
+				Scheme:         observability.UPDATESCRAPECONFIGPAYLOADSCHEME_HTTP.Ptr(),
+				ScrapeInterval: utils.Ptr("5m"),
+				ScrapeTimeout:  utils.Ptr("2m"),
+				SampleLimit:    utils.Ptr(float64(5000)),
+				StaticConfigs:  &[]observability.UpdateScrapeConfigPayloadStaticConfigsInner{},
+			},
+			true,
+		},
 		{
 			"nil_model",
 			nil,
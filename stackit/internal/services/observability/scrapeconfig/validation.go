@@ -0,0 +1,111 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+var relabelActions = []string{
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_REPLACE),
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_KEEP),
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_DROP),
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_HASHMOD),
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_LABELMAP),
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_LABELDROP),
+	string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_LABELKEEP),
+}
+
+// ValidateConfig validates the metrics relabel configs of the scrape config.
+func (r *scrapeConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if utils.IsUndefined(model.RelabelConfigs) {
+		return
+	}
+
+	relabelConfigs := []relabelConfigModel{}
+	resp.Diagnostics.Append(model.RelabelConfigs.ElementsAs(ctx, &relabelConfigs, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for i := range relabelConfigs {
+		validateRelabelConfig(ctx, &resp.Diagnostics, i, &relabelConfigs[i])
+	}
+}
+
+// validateRelabelRegex checks that the regex compiles. Prometheus anchors relabel regexes on both ends.
+func validateRelabelRegex(regex string) error {
+	_, err := regexp.Compile("^(?:" + regex + ")$")
+	return err
+}
+
+// validateRelabelConfig checks that the attributes of a metrics relabel config fit its action.
+// Unset attributes which have a default are null in the config and are not reported. Unknown values are skipped.
+func validateRelabelConfig(ctx context.Context, diags *diag.Diagnostics, index int, rc *relabelConfigModel) {
+	summary := fmt.Sprintf("Error configuring metrics relabel config %d", index)
+
+	if !utils.IsUndefined(rc.Regex) {
+		if err := validateRelabelRegex(rc.Regex.ValueString()); err != nil {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Invalid `regex` %q: %v", rc.Regex.ValueString(), err))
+		}
+	}
+
+	if rc.Action.IsUnknown() {
+		return
+	}
+	action := string(DefaultRelabelAction)
+	if !rc.Action.IsNull() {
+		action = rc.Action.ValueString()
+	}
+
+	switch action {
+	case string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_REPLACE):
+		if rc.TargetLabel.IsNull() {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`target_label` is required for the %q action.", action))
+		}
+	case string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_HASHMOD):
+		if rc.TargetLabel.IsNull() {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`target_label` is required for the %q action.", action))
+		}
+		if rc.Modulus.IsNull() {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`modulus` is required for the %q action.", action))
+		}
+		if rc.SourceLabels.IsNull() {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`source_labels` is required for the %q action.", action))
+		}
+	case string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_KEEP),
+		string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_DROP):
+		if rc.SourceLabels.IsNull() {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`source_labels` is required for the %q action.", action))
+		}
+	case string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_LABELDROP),
+		string(observability.CREATESCRAPECONFIGPAYLOADMETRICSRELABELCONFIGSINNERACTION_LABELKEEP):
+		// These actions only match the regex against the label names
+		attributes := []struct {
+			name  string
+			value attr.Value
+		}{
+			{"source_labels", rc.SourceLabels},
+			{"separator", rc.Separator},
+			{"modulus", rc.Modulus},
+			{"target_label", rc.TargetLabel},
+			{"replacement", rc.Replacement},
+		}
+		for _, a := range attributes {
+			if !a.value.IsNull() {
+				core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`%s` must not be set for the %q action.", a.name, action))
+			}
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateRelabelRegex(t *testing.T) {
+	tests := []struct {
+		regex   string
+		isValid bool
+	}{
+		{"(.*)", true},
+		{"go_.*", true},
+		{"instance|job", true},
+		{"([^:]+):\\d+", true},
+		{"(.*", false},
+		{"[a-", false},
+		{"a(?=b)", false},
+		{"a{2,1}", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.regex, func(t *testing.T) {
+			err := validateRelabelRegex(tt.regex)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateRelabelConfig(t *testing.T) {
+	relabelConfig := func(action string, mods ...func(*relabelConfigModel)) *relabelConfigModel {
+		rc := &relabelConfigModel{
+			Action:       types.StringValue(action),
+			SourceLabels: types.ListNull(types.StringType),
+			Separator:    types.StringNull(),
+			Regex:        types.StringNull(),
+			Modulus:      types.Int64Null(),
+			TargetLabel:  types.StringNull(),
+			Replacement:  types.StringNull(),
+		}
+		for _, mod := range mods {
+			mod(rc)
+		}
+		return rc
+	}
+	sourceLabels := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("__name__")})
+
+	tests := []struct {
+		description string
+		input       *relabelConfigModel
+		isValid     bool
+	}{
+		{
+			"replace_ok",
+			relabelConfig("replace", func(rc *relabelConfigModel) {
+				rc.SourceLabels = sourceLabels
+				rc.TargetLabel = types.StringValue("name")
+			}),
+			true,
+		},
+		{
+			"default_action_ok",
+			relabelConfig("", func(rc *relabelConfigModel) {
+				rc.Action = types.StringNull()
+				rc.TargetLabel = types.StringValue("env")
+				rc.Replacement = types.StringValue("prod")
+			}),
+			true,
+		},
+		{
+			"replace_without_target_label",
+			relabelConfig("replace", func(rc *relabelConfigModel) {
+				rc.SourceLabels = sourceLabels
+			}),
+			false,
+		},
+		{
+			"default_action_without_target_label",
+			relabelConfig("", func(rc *relabelConfigModel) {
+				rc.Action = types.StringNull()
+			}),
+			false,
+		},
+		{
+			"hashmod_ok",
+			relabelConfig("hashmod", func(rc *relabelConfigModel) {
+				rc.SourceLabels = sourceLabels
+				rc.Modulus = types.Int64Value(4)
+				rc.TargetLabel = types.StringValue("shard")
+			}),
+			true,
+		},
+		{
+			"hashmod_without_modulus",
+			relabelConfig("hashmod", func(rc *relabelConfigModel) {
+				rc.SourceLabels = sourceLabels
+				rc.TargetLabel = types.StringValue("shard")
+			}),
+			false,
+		},
+		{
+			"keep_ok",
+			relabelConfig("keep", func(rc *relabelConfigModel) {
+				rc.SourceLabels = sourceLabels
+				rc.Regex = types.StringValue("up|scrape_.*")
+			}),
+			true,
+		},
+		{
+			"drop_without_source_labels",
+			relabelConfig("drop", func(rc *relabelConfigModel) {
+				rc.Regex = types.StringValue("go_.*")
+			}),
+			false,
+		},
+		{
+			"labeldrop_ok",
+			relabelConfig("labeldrop", func(rc *relabelConfigModel) {
+				rc.Regex = types.StringValue("tmp_.*")
+			}),
+			true,
+		},
+		{
+			"labelkeep_with_target_label",
+			relabelConfig("labelkeep", func(rc *relabelConfigModel) {
+				rc.TargetLabel = types.StringValue("name")
+			}),
+			false,
+		},
+		{
+			"labelmap_ok",
+			relabelConfig("labelmap", func(rc *relabelConfigModel) {
+				rc.Regex = types.StringValue("__meta_(.+)")
+			}),
+			true,
+		},
+		{
+			"invalid_regex",
+			relabelConfig("labelmap", func(rc *relabelConfigModel) {
+				rc.Regex = types.StringValue("(.*")
+			}),
+			false,
+		},
+		{
+			"unknown_values",
+			relabelConfig("", func(rc *relabelConfigModel) {
+				rc.Action = types.StringUnknown()
+				rc.Regex = types.StringUnknown()
+			}),
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateRelabelConfig(context.Background(), &diags, 0, tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
@@ -0,0 +1,239 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+// GrafanaUidRegex matches the UIDs accepted by Grafana for folders and dashboards.
+const GrafanaUidRegex = `^[a-zA-Z0-9\-_]{1,40}$`
+
+// grafanaRequestTimeout limits the duration of a single request to the Grafana HTTP API. The provider's transport
+// isn't used, since it authenticates with STACKIT credentials while Grafana uses basic auth.
+const grafanaRequestTimeout = 60 * time.Second
+
+// GrafanaError is returned by the GrafanaClient if the Grafana HTTP API responds with an error status.
+type GrafanaError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *GrafanaError) Error() string {
+	return fmt.Sprintf("grafana API responded with status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsGrafanaNotFound reports whether the error is a 404 response of the Grafana HTTP API.
+func IsGrafanaNotFound(err error) bool {
+	var grafanaErr *GrafanaError
+	return errors.As(err, &grafanaErr) && grafanaErr.StatusCode == http.StatusNotFound
+}
+
+// GrafanaClient is a minimal client for the folder and dashboard endpoints of the Grafana HTTP API,
+// authenticating with basic auth.
+type GrafanaClient struct {
+	httpClient *http.Client
+	baseURL    string
+	username   string
+	password   string
+	userAgent  string
+}
+
+// GrafanaFolder is a folder of the Grafana folder API.
+type GrafanaFolder struct {
+	Id        int64  `json:"id,omitempty"`
+	Uid       string `json:"uid,omitempty"`
+	Title     string `json:"title"`
+	Url       string `json:"url,omitempty"`
+	Version   int64  `json:"version,omitempty"`
+	Overwrite bool   `json:"overwrite,omitempty"`
+}
+
+// GrafanaDashboard is a dashboard of the Grafana dashboard API. The dashboard model is kept as generic JSON.
+type GrafanaDashboard struct {
+	Dashboard map[string]any       `json:"dashboard"`
+	Meta      GrafanaDashboardMeta `json:"meta"`
+}
+
+// GrafanaDashboardMeta holds the metadata of a dashboard.
+type GrafanaDashboardMeta struct {
+	FolderUid string `json:"folderUid"`
+	Url       string `json:"url"`
+	Version   int64  `json:"version"`
+}
+
+// GrafanaSaveDashboardPayload is the payload to create or update a dashboard.
+type GrafanaSaveDashboardPayload struct {
+	Dashboard map[string]any `json:"dashboard"`
+	FolderUid string         `json:"folderUid,omitempty"`
+	Overwrite bool           `json:"overwrite"`
+	Message   string         `json:"message,omitempty"`
+}
+
+// GrafanaSaveDashboardResponse is the response of creating or updating a dashboard.
+type GrafanaSaveDashboardResponse struct {
+	Id      int64  `json:"id"`
+	Uid     string `json:"uid"`
+	Url     string `json:"url"`
+	Version int64  `json:"version"`
+}
+
+// NewGrafanaClient returns a client for the Grafana at baseURL.
+func NewGrafanaClient(baseURL, username, password, userAgent string) *GrafanaClient {
+	return &GrafanaClient{
+		httpClient: &http.Client{Timeout: grafanaRequestTimeout},
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		username:   username,
+		password:   password,
+		userAgent:  userAgent,
+	}
+}
+
+// ConfigureGrafanaClient returns a client for the Grafana of the observability instance. If username and password are
+// empty, the initial Grafana admin credentials of the instance are used.
+func ConfigureGrafanaClient(ctx context.Context, client *observability.APIClient, projectId, instanceId, username, password, providerVersion string) (*GrafanaClient, error) {
+	instanceResp, err := client.GetInstance(ctx, instanceId, projectId).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("getting observability instance: %w", err)
+	}
+	if instanceResp.Instance == nil || instanceResp.Instance.GrafanaUrl == nil || *instanceResp.Instance.GrafanaUrl == "" {
+		return nil, fmt.Errorf("observability instance has no Grafana URL")
+	}
+	instance := instanceResp.Instance
+
+	if username == "" && password == "" {
+		if instance.GrafanaAdminUser == nil || instance.GrafanaAdminPassword == nil {
+			return nil, fmt.Errorf("observability instance has no Grafana admin credentials, configure `username` and `password`")
+		}
+		username = *instance.GrafanaAdminUser
+		password = *instance.GrafanaAdminPassword
+	}
+	userAgent := fmt.Sprintf("stackit-terraform-provider/%s", providerVersion)
+	return NewGrafanaClient(*instance.GrafanaUrl, username, password, userAgent), nil
+}
+
+// GetFolder returns the folder with the given UID.
+func (c *GrafanaClient) GetFolder(ctx context.Context, uid string) (*GrafanaFolder, error) {
+	folder := &GrafanaFolder{}
+	err := c.do(ctx, http.MethodGet, "/api/folders/"+url.PathEscape(uid), nil, folder)
+	if err != nil {
+		return nil, err
+	}
+	return folder, nil
+}
+
+// CreateFolder creates a folder. If the UID of the folder is empty, Grafana generates one.
+func (c *GrafanaClient) CreateFolder(ctx context.Context, folder *GrafanaFolder) (*GrafanaFolder, error) {
+	created := &GrafanaFolder{}
+	err := c.do(ctx, http.MethodPost, "/api/folders", folder, created)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+// UpdateFolder updates the title of the folder with the given UID, overwriting changes made in the meantime.
+func (c *GrafanaClient) UpdateFolder(ctx context.Context, uid, title string) (*GrafanaFolder, error) {
+	updated := &GrafanaFolder{}
+	payload := &GrafanaFolder{Title: title, Overwrite: true}
+	err := c.do(ctx, http.MethodPut, "/api/folders/"+url.PathEscape(uid), payload, updated)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// DeleteFolder deletes the folder with the given UID, including the dashboards in it.
+func (c *GrafanaClient) DeleteFolder(ctx context.Context, uid string) error {
+	return c.do(ctx, http.MethodDelete, "/api/folders/"+url.PathEscape(uid), nil, nil)
+}
+
+// GetDashboard returns the dashboard with the given UID.
+func (c *GrafanaClient) GetDashboard(ctx context.Context, uid string) (*GrafanaDashboard, error) {
+	dashboard := &GrafanaDashboard{}
+	err := c.do(ctx, http.MethodGet, "/api/dashboards/uid/"+url.PathEscape(uid), nil, dashboard)
+	if err != nil {
+		return nil, err
+	}
+	return dashboard, nil
+}
+
+// SaveDashboard creates or updates a dashboard.
+func (c *GrafanaClient) SaveDashboard(ctx context.Context, payload *GrafanaSaveDashboardPayload) (*GrafanaSaveDashboardResponse, error) {
+	saved := &GrafanaSaveDashboardResponse{}
+	err := c.do(ctx, http.MethodPost, "/api/dashboards/db", payload, saved)
+	if err != nil {
+		return nil, err
+	}
+	return saved, nil
+}
+
+// DeleteDashboard deletes the dashboard with the given UID.
+func (c *GrafanaClient) DeleteDashboard(ctx context.Context, uid string) error {
+	return c.do(ctx, http.MethodDelete, "/api/dashboards/uid/"+url.PathEscape(uid), nil, nil)
+}
+
+// do sends a request to the Grafana HTTP API and decodes the JSON response into result, if result is not nil.
+func (c *GrafanaClient) do(ctx context.Context, method, path string, payload, result any) error {
+	var body io.Reader
+	if payload != nil {
+		payloadBytes, err := json.Marshal(payload)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+		body = bytes.NewReader(payloadBytes)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling Grafana API: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	respBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		grafanaErr := &GrafanaError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(respBytes))}
+		var errResp struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(respBytes, &errResp) == nil && errResp.Message != "" {
+			grafanaErr.Message = errResp.Message
+		}
+		return grafanaErr
+	}
+
+	if result == nil {
+		return nil
+	}
+	if err := json.Unmarshal(respBytes, result); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,350 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/stackitcloud/stackit-sdk-go/core/config"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+const (
+	testGrafanaUser     = "admin"
+	testGrafanaPassword = "secret"
+)
+
+// fakeGrafana is a stand-in for the folder and dashboard endpoints of the Grafana HTTP API.
+type fakeGrafana struct {
+	mu         sync.Mutex
+	folders    map[string]*GrafanaFolder
+	dashboards map[string]*GrafanaDashboard
+	nextId     int64
+}
+
+func newFakeGrafana(t *testing.T) *httptest.Server {
+	t.Helper()
+	g := &fakeGrafana{
+		folders:    map[string]*GrafanaFolder{},
+		dashboards: map[string]*GrafanaDashboard{},
+	}
+	server := httptest.NewServer(http.HandlerFunc(g.serveHTTP))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (g *fakeGrafana) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	user, password, ok := r.BasicAuth()
+	if !ok || user != testGrafanaUser || password != testGrafanaPassword {
+		writeJSON(w, http.StatusUnauthorized, map[string]any{"message": "invalid username or password"})
+		return
+	}
+
+	switch {
+	case r.URL.Path == "/api/folders" && r.Method == http.MethodPost:
+		folder := &GrafanaFolder{}
+		if err := json.NewDecoder(r.Body).Decode(folder); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+		g.nextId++
+		if folder.Uid == "" {
+			folder.Uid = fmt.Sprintf("generated-%d", g.nextId)
+		}
+		if _, exists := g.folders[folder.Uid]; exists {
+			writeJSON(w, http.StatusConflict, map[string]any{"message": "a folder with the same uid already exists"})
+			return
+		}
+		folder.Id = g.nextId
+		folder.Version = 1
+		folder.Url = "/dashboards/f/" + folder.Uid
+		g.folders[folder.Uid] = folder
+		writeJSON(w, http.StatusOK, folder)
+	case strings.HasPrefix(r.URL.Path, "/api/folders/"):
+		uid := strings.TrimPrefix(r.URL.Path, "/api/folders/")
+		folder, exists := g.folders[uid]
+		if !exists {
+			writeJSON(w, http.StatusNotFound, map[string]any{"message": "folder not found"})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, folder)
+		case http.MethodPut:
+			update := &GrafanaFolder{}
+			if err := json.NewDecoder(r.Body).Decode(update); err != nil {
+				writeJSON(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+				return
+			}
+			folder.Title = update.Title
+			folder.Version++
+			writeJSON(w, http.StatusOK, folder)
+		case http.MethodDelete:
+			delete(g.folders, uid)
+			writeJSON(w, http.StatusOK, map[string]any{"message": "Folder deleted"})
+		}
+	case r.URL.Path == "/api/dashboards/db" && r.Method == http.MethodPost:
+		payload := &GrafanaSaveDashboardPayload{}
+		if err := json.NewDecoder(r.Body).Decode(payload); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"message": err.Error()})
+			return
+		}
+		uid, _ := payload.Dashboard["uid"].(string)
+		if uid == "" {
+			g.nextId++
+			uid = fmt.Sprintf("generated-%d", g.nextId)
+		}
+		existing, exists := g.dashboards[uid]
+		if exists && !payload.Overwrite {
+			writeJSON(w, http.StatusPreconditionFailed, map[string]any{"message": "A dashboard with the same uid already exists"})
+			return
+		}
+		version := int64(1)
+		if exists {
+			version = existing.Meta.Version + 1
+		}
+		g.nextId++
+		payload.Dashboard["uid"] = uid
+		payload.Dashboard["id"] = g.nextId
+		payload.Dashboard["version"] = version
+		url := "/d/" + uid + "/" + strings.ToLower(fmt.Sprint(payload.Dashboard["title"]))
+		g.dashboards[uid] = &GrafanaDashboard{
+			Dashboard: payload.Dashboard,
+			Meta:      GrafanaDashboardMeta{FolderUid: payload.FolderUid, Url: url, Version: version},
+		}
+		writeJSON(w, http.StatusOK, GrafanaSaveDashboardResponse{Id: g.nextId, Uid: uid, Url: url, Version: version})
+	case strings.HasPrefix(r.URL.Path, "/api/dashboards/uid/"):
+		uid := strings.TrimPrefix(r.URL.Path, "/api/dashboards/uid/")
+		dashboard, exists := g.dashboards[uid]
+		if !exists {
+			writeJSON(w, http.StatusNotFound, map[string]any{"message": "Dashboard not found"})
+			return
+		}
+		switch r.Method {
+		case http.MethodGet:
+			writeJSON(w, http.StatusOK, dashboard)
+		case http.MethodDelete:
+			delete(g.dashboards, uid)
+			writeJSON(w, http.StatusOK, map[string]any{"message": "Dashboard deleted"})
+		}
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]any{"message": "Not found"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func TestGrafanaClientFolders(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeGrafana(t)
+	client := NewGrafanaClient(server.URL+"/", testGrafanaUser, testGrafanaPassword, "test")
+
+	created, err := client.CreateFolder(ctx, &GrafanaFolder{Uid: "team-a", Title: "Team A"})
+	if err != nil {
+		t.Fatalf("Create folder: %v", err)
+	}
+	if created.Uid != "team-a" || created.Url == "" {
+		t.Fatalf("Unexpected created folder: %+v", created)
+	}
+
+	generated, err := client.CreateFolder(ctx, &GrafanaFolder{Title: "Generated"})
+	if err != nil {
+		t.Fatalf("Create folder without uid: %v", err)
+	}
+	if generated.Uid == "" {
+		t.Fatalf("Expected generated uid")
+	}
+
+	updated, err := client.UpdateFolder(ctx, "team-a", "Team A (renamed)")
+	if err != nil {
+		t.Fatalf("Update folder: %v", err)
+	}
+	if updated.Title != "Team A (renamed)" {
+		t.Fatalf("Unexpected updated title: %q", updated.Title)
+	}
+
+	read, err := client.GetFolder(ctx, "team-a")
+	if err != nil {
+		t.Fatalf("Get folder: %v", err)
+	}
+	diff := cmp.Diff(read, updated)
+	if diff != "" {
+		t.Fatalf("Folder does not match: %s", diff)
+	}
+
+	err = client.DeleteFolder(ctx, "team-a")
+	if err != nil {
+		t.Fatalf("Delete folder: %v", err)
+	}
+	_, err = client.GetFolder(ctx, "team-a")
+	if !IsGrafanaNotFound(err) {
+		t.Fatalf("Expected not found error, got: %v", err)
+	}
+}
+
+func TestGrafanaClientDashboards(t *testing.T) {
+	ctx := context.Background()
+	server := newFakeGrafana(t)
+	client := NewGrafanaClient(server.URL, testGrafanaUser, testGrafanaPassword, "test")
+
+	saved, err := client.SaveDashboard(ctx, &GrafanaSaveDashboardPayload{
+		Dashboard: map[string]any{"uid": "nodes", "title": "Nodes"},
+		FolderUid: "team-a",
+	})
+	if err != nil {
+		t.Fatalf("Save dashboard: %v", err)
+	}
+	if saved.Uid != "nodes" || saved.Version != 1 {
+		t.Fatalf("Unexpected save response: %+v", saved)
+	}
+
+	_, err = client.SaveDashboard(ctx, &GrafanaSaveDashboardPayload{
+		Dashboard: map[string]any{"uid": "nodes", "title": "Nodes"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "412") {
+		t.Fatalf("Expected precondition failed error, got: %v", err)
+	}
+
+	saved, err = client.SaveDashboard(ctx, &GrafanaSaveDashboardPayload{
+		Dashboard: map[string]any{"uid": "nodes", "title": "Nodes v2"},
+		FolderUid: "team-a",
+		Overwrite: true,
+	})
+	if err != nil {
+		t.Fatalf("Overwrite dashboard: %v", err)
+	}
+	if saved.Version != 2 {
+		t.Fatalf("Expected version 2, got %d", saved.Version)
+	}
+
+	dashboard, err := client.GetDashboard(ctx, "nodes")
+	if err != nil {
+		t.Fatalf("Get dashboard: %v", err)
+	}
+	if dashboard.Dashboard["title"] != "Nodes v2" || dashboard.Meta.FolderUid != "team-a" || dashboard.Meta.Version != 2 {
+		t.Fatalf("Unexpected dashboard: %+v", dashboard)
+	}
+
+	err = client.DeleteDashboard(ctx, "nodes")
+	if err != nil {
+		t.Fatalf("Delete dashboard: %v", err)
+	}
+	err = client.DeleteDashboard(ctx, "nodes")
+	if !IsGrafanaNotFound(err) {
+		t.Fatalf("Expected not found error, got: %v", err)
+	}
+}
+
+func TestGrafanaClientUnauthorized(t *testing.T) {
+	server := newFakeGrafana(t)
+	client := NewGrafanaClient(server.URL, testGrafanaUser, "wrong", "test")
+
+	_, err := client.GetFolder(context.Background(), "team-a")
+	if err == nil {
+		t.Fatalf("Should have failed")
+	}
+	expected := &GrafanaError{StatusCode: http.StatusUnauthorized, Message: "invalid username or password"}
+	diff := cmp.Diff(err, error(expected))
+	if diff != "" {
+		t.Fatalf("Error does not match: %s", diff)
+	}
+}
+
+func TestConfigureGrafanaClient(t *testing.T) {
+	grafana := newFakeGrafana(t)
+
+	tests := []struct {
+		description string
+		instance    *observability.InstanceSensitiveData
+		username    string
+		password    string
+		isValid     bool
+	}{
+		{
+			"instance_admin_credentials",
+			&observability.InstanceSensitiveData{
+				GrafanaUrl:           utils.Ptr(grafana.URL),
+				GrafanaAdminUser:     utils.Ptr(testGrafanaUser),
+				GrafanaAdminPassword: utils.Ptr(testGrafanaPassword),
+			},
+			"",
+			"",
+			true,
+		},
+		{
+			"configured_credentials",
+			&observability.InstanceSensitiveData{
+				GrafanaUrl: utils.Ptr(grafana.URL),
+			},
+			testGrafanaUser,
+			testGrafanaPassword,
+			true,
+		},
+		{
+			"no_credentials",
+			&observability.InstanceSensitiveData{
+				GrafanaUrl: utils.Ptr(grafana.URL),
+			},
+			"",
+			"",
+			false,
+		},
+		{
+			"no_grafana_url",
+			&observability.InstanceSensitiveData{},
+			testGrafanaUser,
+			testGrafanaPassword,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v1/projects/pid/instances/iid" {
+					writeJSON(w, http.StatusNotFound, map[string]any{"message": "not found"})
+					return
+				}
+				writeJSON(w, http.StatusOK, observability.GetInstanceResponse{Instance: tt.instance})
+			}))
+			defer api.Close()
+			apiClient, err := observability.NewAPIClient(
+				config.WithEndpoint(api.URL),
+				config.WithoutAuthentication(),
+			)
+			if err != nil {
+				t.Fatalf("Failed to initialize client: %v", err)
+			}
+
+			client, err := ConfigureGrafanaClient(context.Background(), apiClient, "pid", "iid", tt.username, tt.password, "1.2.3")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if client.userAgent != "stackit-terraform-provider/1.2.3" {
+					t.Fatalf("Unexpected user agent: %q", client.userAgent)
+				}
+				_, err = client.GetFolder(context.Background(), "missing")
+				if !IsGrafanaNotFound(err) {
+					t.Fatalf("Expected authenticated not found error, got: %v", err)
+				}
+			}
+		})
+	}
+}
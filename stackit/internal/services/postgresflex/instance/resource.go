@@ -40,6 +40,12 @@ var (
 	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
+// Caches of the catalog endpoints used for the plan-time validation
+var (
+	flavorsCache  utils.CatalogCache[[]utils.FlavorSpec]
+	storagesCache utils.CatalogCache[*utils.StorageOptions]
+)
+
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
 	InstanceId     types.String `tfsdk:"instance_id"`
@@ -94,7 +100,7 @@ type instanceResource struct {
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
-// Use the modifier to set the effective region in the current plan.
+// Use the modifier to set the effective region in the current plan and to validate the flavor and storage.
 func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
 	var configModel Model
 	// skip initial empty configuration to avoid follow-up errors
@@ -117,12 +123,93 @@ func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	if !req.Plan.Raw.IsNull() {
+		var stateModel *Model
+		if !req.State.Raw.IsNull() {
+			stateModel = &Model{}
+			resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		r.validateFlavorAndStorage(ctx, &resp.Diagnostics, &planModel, stateModel)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// validateFlavorAndStorage validates the configured flavor and storage against the flavors and
+// storage options offered by PostgreSQL Flex. state is nil on create, see utils.ValidateFlavorStorage.
+func (r *instanceResource) validateFlavorAndStorage(ctx context.Context, diags *diag.Diagnostics, model, state *Model) {
+	if utils.IsUndefined(model.ProjectId) || utils.IsUndefined(model.Region) {
+		return
+	}
+	flavorStorage := toFlavorStorage(ctx, diags, model)
+	var stateFlavorStorage *utils.FlavorStorage
+	if state != nil {
+		stateValue := toFlavorStorage(ctx, diags, state)
+		stateFlavorStorage = &stateValue
+	}
+	if diags.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	loadFlavors := func() ([]utils.FlavorSpec, error) {
+		return flavorsCache.Get(projectId+core.Separator+region, func() ([]utils.FlavorSpec, error) {
+			res, err := r.client.ListFlavors(ctx, projectId, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			specs := []utils.FlavorSpec{}
+			for _, f := range res.GetFlavors() {
+				specs = append(specs, utils.FlavorSpec{Id: f.GetId(), CPU: f.GetCpu(), RAM: f.GetMemory()})
+			}
+			return specs, nil
+		})
+	}
+	loadStorageOptions := func(flavorId string) (*utils.StorageOptions, error) {
+		return storagesCache.Get(projectId+core.Separator+region+core.Separator+flavorId, func() (*utils.StorageOptions, error) {
+			res, err := r.client.ListStorages(ctx, projectId, region, flavorId).Execute()
+			if err != nil {
+				return nil, err
+			}
+			options := &utils.StorageOptions{Classes: res.GetStorageClasses()}
+			if storageRange, ok := res.GetStorageRangeOk(); ok {
+				options.MinSize = storageRange.Min
+				options.MaxSize = storageRange.Max
+			}
+			return options, nil
+		})
+	}
+	utils.ValidateFlavorStorage(ctx, diags, "PostgreSQL Flex", flavorStorage, stateFlavorStorage, loadFlavors, loadStorageOptions)
+}
+
+// toFlavorStorage returns the flavor and storage of the model. Values of unset objects are null.
+func toFlavorStorage(ctx context.Context, diags *diag.Diagnostics, model *Model) utils.FlavorStorage {
+	flavor := &flavorModel{}
+	if !utils.IsUndefined(model.Flavor) {
+		diags.Append(model.Flavor.As(ctx, flavor, basetypes.ObjectAsOptions{})...)
+	}
+	storage := &storageModel{}
+	if !utils.IsUndefined(model.Storage) {
+		diags.Append(model.Storage.As(ctx, storage, basetypes.ObjectAsOptions{})...)
+	}
+	return utils.FlavorStorage{
+		CPU:          flavor.CPU,
+		RAM:          flavor.RAM,
+		StorageClass: storage.Class,
+		StorageSize:  storage.Size,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_postgresflex_instance"
@@ -322,9 +409,10 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	}
 	instanceId := *createResp.Id
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, region, instanceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.CreateInstanceWaitHandler(waitCtx, r.client, projectId, region, instanceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance creation waiting: %v", err)))
 		return
 	}
 
@@ -463,9 +551,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	waitResp, err := wait.PartialUpdateInstanceWaitHandler(ctx, r.client, projectId, region, instanceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.PartialUpdateInstanceWaitHandler(waitCtx, r.client, projectId, region, instanceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance update waiting: %v", err)))
 		return
 	}
 
@@ -505,9 +594,10 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, region, instanceId).SetTimeout(45 * time.Minute).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	_, err = wait.DeleteInstanceWaitHandler(waitCtx, r.client, projectId, region, instanceId).SetTimeout(45 * time.Minute).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "Postgres Flex instance deleted")
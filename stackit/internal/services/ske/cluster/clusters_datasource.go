@@ -0,0 +1,467 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &clustersDataSource{}
+)
+
+// NewClustersDataSource is a helper function to simplify the provider implementation.
+func NewClustersDataSource() datasource.DataSource {
+	return &clustersDataSource{}
+}
+
+// clustersDataSource is the data source implementation.
+type clustersDataSource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// clustersDataSourceModel maps the data source schema data.
+type clustersDataSourceModel struct {
+	Id        types.String        `tfsdk:"id"` // needed by TF
+	ProjectId types.String        `tfsdk:"project_id"`
+	Region    types.String        `tfsdk:"region"`
+	NameRegex types.String        `tfsdk:"name_regex"`
+	Statuses  types.List          `tfsdk:"statuses"`
+	Items     []clustersItemModel `tfsdk:"items"`
+}
+
+// clustersItemModel maps a cluster of the list.
+type clustersItemModel struct {
+	Name                  types.String             `tfsdk:"name"`
+	KubernetesVersionUsed types.String             `tfsdk:"kubernetes_version_used"`
+	Status                types.String             `tfsdk:"status"`
+	Hibernated            types.Bool               `tfsdk:"hibernated"`
+	CreationTime          types.String             `tfsdk:"creation_time"`
+	EgressAddressRanges   types.List               `tfsdk:"egress_address_ranges"`
+	NodePools             []clustersNodePoolModel  `tfsdk:"node_pools"`
+	Extensions            *clustersExtensionsModel `tfsdk:"extensions"`
+}
+
+// clustersNodePoolModel maps the summary of a node pool of a cluster of the list.
+type clustersNodePoolModel struct {
+	Name              types.String `tfsdk:"name"`
+	MachineType       types.String `tfsdk:"machine_type"`
+	OSName            types.String `tfsdk:"os_name"`
+	OSVersionUsed     types.String `tfsdk:"os_version_used"`
+	Minimum           types.Int64  `tfsdk:"minimum"`
+	Maximum           types.Int64  `tfsdk:"maximum"`
+	AvailabilityZones types.List   `tfsdk:"availability_zones"`
+}
+
+// clustersExtensionsModel maps the summary of the extensions of a cluster of the list.
+type clustersExtensionsModel struct {
+	ACLEnabled              types.Bool   `tfsdk:"acl_enabled"`
+	DNSEnabled              types.Bool   `tfsdk:"dns_enabled"`
+	DNSZones                types.List   `tfsdk:"dns_zones"`
+	ObservabilityEnabled    types.Bool   `tfsdk:"observability_enabled"`
+	ObservabilityInstanceId types.String `tfsdk:"observability_instance_id"`
+}
+
+// Metadata returns the data source type name.
+func (r *clustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_clusters"
+}
+
+// Configure adds the provider configured client to the data source.
+func (r *clustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the data source.
+func (r *clustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	statuses := []string{}
+	for _, state := range ske.AllowedClusterStatusStateEnumValues {
+		statuses = append(statuses, string(state))
+	}
+
+	descriptions := map[string]string{
+		"main":                      "SKE clusters data source schema. Lists all clusters of a project in a region. Must have a `region` specified in the provider configuration.",
+		"id":                        "Terraform's internal data source identifier. It is structured as \"`project_id`,`region`\".",
+		"project_id":                "STACKIT project ID to which the clusters are associated.",
+		"region":                    "The resource region. If not defined, the provider region is used.",
+		"name_regex":                "Regular expression to filter the clusters by name, e.g. `^prod-`.",
+		"statuses":                  fmt.Sprintf("Only list clusters with one of these aggregated statuses. %s", utils.FormatPossibleValues(statuses...)),
+		"items":                     "The clusters, sorted by name.",
+		"name":                      "The cluster name.",
+		"kubernetes_version_used":   "Full Kubernetes version used.",
+		"status":                    "The aggregated status of the cluster, e.g. `STATE_HEALTHY` or `STATE_CREATING`.",
+		"hibernated":                "Whether the cluster is hibernated.",
+		"creation_time":             "Date-time when the cluster was created.",
+		"egress_address_ranges":     "The outgoing network ranges (in CIDR notation) of traffic originating from workload on the cluster.",
+		"node_pools":                "Summary of the node pools of the cluster.",
+		"node_pools.name":           "The node pool name.",
+		"machine_type":              "The machine type.",
+		"os_name":                   "The name of the OS image.",
+		"os_version_used":           "Full OS image version used.",
+		"minimum":                   "Minimum number of nodes in the pool.",
+		"maximum":                   "Maximum number of nodes in the pool.",
+		"availability_zones":        "Specify a list of availability zones.",
+		"extensions":                "Summary of the extensions of the cluster.",
+		"acl_enabled":               "Whether the ACL extension is enabled.",
+		"dns_enabled":               "Whether the DNS extension is enabled.",
+		"dns_zones":                 "Zones of the DNS extension.",
+		"observability_enabled":     "Whether the observability extension is enabled.",
+		"observability_instance_id": "Observability instance ID of the observability extension.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				// the region cannot be found, so it has to be passed
+				Optional:    true,
+				Description: descriptions["region"],
+			},
+			"name_regex": schema.StringAttribute{
+				Description: descriptions["name_regex"],
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"statuses": schema.ListAttribute{
+				Description: descriptions["statuses"],
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf(statuses...),
+					),
+				},
+			},
+			"items": schema.ListNestedAttribute{
+				Description: descriptions["items"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: descriptions["name"],
+							Computed:    true,
+						},
+						"kubernetes_version_used": schema.StringAttribute{
+							Description: descriptions["kubernetes_version_used"],
+							Computed:    true,
+						},
+						"status": schema.StringAttribute{
+							Description: descriptions["status"],
+							Computed:    true,
+						},
+						"hibernated": schema.BoolAttribute{
+							Description: descriptions["hibernated"],
+							Computed:    true,
+						},
+						"creation_time": schema.StringAttribute{
+							Description: descriptions["creation_time"],
+							Computed:    true,
+						},
+						"egress_address_ranges": schema.ListAttribute{
+							Description: descriptions["egress_address_ranges"],
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+						"node_pools": schema.ListNestedAttribute{
+							Description: descriptions["node_pools"],
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"name": schema.StringAttribute{
+										Description: descriptions["node_pools.name"],
+										Computed:    true,
+									},
+									"machine_type": schema.StringAttribute{
+										Description: descriptions["machine_type"],
+										Computed:    true,
+									},
+									"os_name": schema.StringAttribute{
+										Description: descriptions["os_name"],
+										Computed:    true,
+									},
+									"os_version_used": schema.StringAttribute{
+										Description: descriptions["os_version_used"],
+										Computed:    true,
+									},
+									"minimum": schema.Int64Attribute{
+										Description: descriptions["minimum"],
+										Computed:    true,
+									},
+									"maximum": schema.Int64Attribute{
+										Description: descriptions["maximum"],
+										Computed:    true,
+									},
+									"availability_zones": schema.ListAttribute{
+										Description: descriptions["availability_zones"],
+										Computed:    true,
+										ElementType: types.StringType,
+									},
+								},
+							},
+						},
+						"extensions": schema.SingleNestedAttribute{
+							Description: descriptions["extensions"],
+							Computed:    true,
+							Attributes: map[string]schema.Attribute{
+								"acl_enabled": schema.BoolAttribute{
+									Description: descriptions["acl_enabled"],
+									Computed:    true,
+								},
+								"dns_enabled": schema.BoolAttribute{
+									Description: descriptions["dns_enabled"],
+									Computed:    true,
+								},
+								"dns_zones": schema.ListAttribute{
+									Description: descriptions["dns_zones"],
+									Computed:    true,
+									ElementType: types.StringType,
+								},
+								"observability_enabled": schema.BoolAttribute{
+									Description: descriptions["observability_enabled"],
+									Computed:    true,
+								},
+								"observability_instance_id": schema.StringAttribute{
+									Description: descriptions["observability_instance_id"],
+									Computed:    true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *clustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model clustersDataSourceModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	clustersResp, err := r.client.ListClustersExecute(ctx, projectId, region)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading clusters",
+			fmt.Sprintf("Clusters of project %q could not be listed.", projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapClustersFields(ctx, clustersResp, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading clusters", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE clusters read")
+}
+
+func mapClustersFields(ctx context.Context, clustersResp *ske.ListClustersResponse, model *clustersDataSourceModel, region string) error {
+	if clustersResp == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	var nameRegex *regexp.Regexp
+	if !utils.IsUndefined(model.NameRegex) {
+		var err error
+		nameRegex, err = regexp.Compile(model.NameRegex.ValueString())
+		if err != nil {
+			return fmt.Errorf("parsing name_regex: %w", err)
+		}
+	}
+	statuses := []string{}
+	if !utils.IsUndefined(model.Statuses) {
+		diags := model.Statuses.ElementsAs(ctx, &statuses, false)
+		if diags.HasError() {
+			return fmt.Errorf("parsing statuses: %w", core.DiagsToError(diags))
+		}
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region)
+	model.Region = types.StringValue(region)
+
+	clusters := clustersResp.GetItems()
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return clusters[i].GetName() < clusters[j].GetName()
+	})
+
+	model.Items = []clustersItemModel{}
+	for i := range clusters {
+		cl := &clusters[i]
+		if nameRegex != nil && !nameRegex.MatchString(cl.GetName()) {
+			continue
+		}
+		status := ""
+		if cl.Status != nil {
+			status = string(cl.Status.GetAggregated())
+		}
+		if len(statuses) > 0 && !slices.Contains(statuses, status) {
+			continue
+		}
+
+		item, err := mapClustersItem(ctx, cl)
+		if err != nil {
+			return fmt.Errorf("mapping cluster %q: %w", cl.GetName(), err)
+		}
+		model.Items = append(model.Items, *item)
+	}
+	return nil
+}
+
+func mapClustersItem(ctx context.Context, cl *ske.Cluster) (*clustersItemModel, error) {
+	item := &clustersItemModel{
+		Name:                  types.StringPointerValue(cl.Name),
+		KubernetesVersionUsed: types.StringNull(),
+		Status:                types.StringNull(),
+		Hibernated:            types.BoolNull(),
+		CreationTime:          types.StringNull(),
+		EgressAddressRanges:   types.ListNull(types.StringType),
+		NodePools:             []clustersNodePoolModel{},
+	}
+	if cl.Kubernetes != nil {
+		item.KubernetesVersionUsed = types.StringPointerValue(cl.Kubernetes.Version)
+	}
+	if cl.Status != nil {
+		if cl.Status.Aggregated != nil {
+			item.Status = types.StringValue(string(*cl.Status.Aggregated))
+		}
+		item.Hibernated = types.BoolPointerValue(cl.Status.Hibernated)
+		if cl.Status.CreationTime != nil {
+			item.CreationTime = types.StringValue(cl.Status.CreationTime.Format(time.RFC3339))
+		}
+		if cl.Status.EgressAddressRanges != nil {
+			egressAddressRanges, diags := types.ListValueFrom(ctx, types.StringType, *cl.Status.EgressAddressRanges)
+			if diags.HasError() {
+				return nil, fmt.Errorf("mapping egress address ranges: %w", core.DiagsToError(diags))
+			}
+			item.EgressAddressRanges = egressAddressRanges
+		}
+	}
+
+	for _, np := range cl.GetNodepools() {
+		nodePool := clustersNodePoolModel{
+			Name:              types.StringPointerValue(np.Name),
+			MachineType:       types.StringNull(),
+			OSName:            types.StringNull(),
+			OSVersionUsed:     types.StringNull(),
+			Minimum:           types.Int64PointerValue(np.Minimum),
+			Maximum:           types.Int64PointerValue(np.Maximum),
+			AvailabilityZones: types.ListNull(types.StringType),
+		}
+		if np.Machine != nil {
+			nodePool.MachineType = types.StringPointerValue(np.Machine.Type)
+			if np.Machine.Image != nil {
+				nodePool.OSName = types.StringPointerValue(np.Machine.Image.Name)
+				nodePool.OSVersionUsed = types.StringPointerValue(np.Machine.Image.Version)
+			}
+		}
+		if np.AvailabilityZones != nil {
+			availabilityZones, diags := types.ListValueFrom(ctx, types.StringType, *np.AvailabilityZones)
+			if diags.HasError() {
+				return nil, fmt.Errorf("mapping availability zones of node pool %q: %w", np.GetName(), core.DiagsToError(diags))
+			}
+			nodePool.AvailabilityZones = availabilityZones
+		}
+		item.NodePools = append(item.NodePools, nodePool)
+	}
+
+	extensionsModel := &clustersExtensionsModel{
+		ACLEnabled:              types.BoolValue(false),
+		DNSEnabled:              types.BoolValue(false),
+		DNSZones:                types.ListNull(types.StringType),
+		ObservabilityEnabled:    types.BoolValue(false),
+		ObservabilityInstanceId: types.StringNull(),
+	}
+	if ex := cl.Extensions; ex != nil {
+		if ex.Acl != nil {
+			extensionsModel.ACLEnabled = types.BoolValue(ex.Acl.GetEnabled())
+		}
+		if ex.Dns != nil {
+			extensionsModel.DNSEnabled = types.BoolValue(ex.Dns.GetEnabled())
+			if ex.Dns.Zones != nil {
+				zones, diags := types.ListValueFrom(ctx, types.StringType, *ex.Dns.Zones)
+				if diags.HasError() {
+					return nil, fmt.Errorf("mapping DNS zones: %w", core.DiagsToError(diags))
+				}
+				extensionsModel.DNSZones = zones
+			}
+		}
+		if ex.Observability != nil {
+			extensionsModel.ObservabilityEnabled = types.BoolValue(ex.Observability.GetEnabled())
+			extensionsModel.ObservabilityInstanceId = types.StringPointerValue(ex.Observability.InstanceId)
+		}
+	}
+	item.Extensions = extensionsModel
+	return item, nil
+}
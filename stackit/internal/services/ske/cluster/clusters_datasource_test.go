@@ -0,0 +1,224 @@
+package ske
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapClustersFields(t *testing.T) {
+	creationTime := time.Date(2024, 2, 5, 14, 40, 12, 0, time.UTC)
+	clusters := &ske.ListClustersResponse{
+		Items: &[]ske.Cluster{
+			{
+				Name: utils.Ptr("prod-b"),
+				Kubernetes: &ske.Kubernetes{
+					Version: utils.Ptr("1.31.1"),
+				},
+				Status: &ske.ClusterStatus{
+					Aggregated:          ske.CLUSTERSTATUSSTATE_HIBERNATED.Ptr(),
+					Hibernated:          utils.Ptr(true),
+					CreationTime:        utils.Ptr(creationTime),
+					EgressAddressRanges: &[]string{"0.0.0.0/32"},
+				},
+				Nodepools: &[]ske.Nodepool{
+					{
+						Name:              utils.Ptr("np"),
+						Minimum:           utils.Ptr(int64(1)),
+						Maximum:           utils.Ptr(int64(3)),
+						AvailabilityZones: &[]string{"eu01-1"},
+						Machine: &ske.Machine{
+							Type: utils.Ptr("c1.2"),
+							Image: &ske.Image{
+								Name:    utils.Ptr("flatcar"),
+								Version: utils.Ptr("3815.2.5"),
+							},
+						},
+					},
+				},
+				Extensions: &ske.Extension{
+					Acl: &ske.ACL{
+						Enabled: utils.Ptr(true),
+					},
+					Dns: &ske.DNS{
+						Enabled: utils.Ptr(true),
+						Zones:   &[]string{"example.com"},
+					},
+					Observability: &ske.Observability{
+						Enabled:    utils.Ptr(true),
+						InstanceId: utils.Ptr("oid"),
+					},
+				},
+			},
+			{
+				Name: utils.Ptr("prod-a"),
+				Status: &ske.ClusterStatus{
+					Aggregated: ske.CLUSTERSTATUSSTATE_HEALTHY.Ptr(),
+				},
+			},
+			{
+				Name: utils.Ptr("dev"),
+				Status: &ske.ClusterStatus{
+					Aggregated: ske.CLUSTERSTATUSSTATE_UNHEALTHY.Ptr(),
+				},
+			},
+		},
+	}
+	prodA := clustersItemModel{
+		Name:                  types.StringValue("prod-a"),
+		KubernetesVersionUsed: types.StringNull(),
+		Status:                types.StringValue("STATE_HEALTHY"),
+		Hibernated:            types.BoolNull(),
+		CreationTime:          types.StringNull(),
+		EgressAddressRanges:   types.ListNull(types.StringType),
+		NodePools:             []clustersNodePoolModel{},
+		Extensions: &clustersExtensionsModel{
+			ACLEnabled:              types.BoolValue(false),
+			DNSEnabled:              types.BoolValue(false),
+			DNSZones:                types.ListNull(types.StringType),
+			ObservabilityEnabled:    types.BoolValue(false),
+			ObservabilityInstanceId: types.StringNull(),
+		},
+	}
+	prodB := clustersItemModel{
+		Name:                  types.StringValue("prod-b"),
+		KubernetesVersionUsed: types.StringValue("1.31.1"),
+		Status:                types.StringValue("STATE_HIBERNATED"),
+		Hibernated:            types.BoolValue(true),
+		CreationTime:          types.StringValue("2024-02-05T14:40:12Z"),
+		EgressAddressRanges:   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("0.0.0.0/32")}),
+		NodePools: []clustersNodePoolModel{
+			{
+				Name:              types.StringValue("np"),
+				MachineType:       types.StringValue("c1.2"),
+				OSName:            types.StringValue("flatcar"),
+				OSVersionUsed:     types.StringValue("3815.2.5"),
+				Minimum:           types.Int64Value(1),
+				Maximum:           types.Int64Value(3),
+				AvailabilityZones: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1")}),
+			},
+		},
+		Extensions: &clustersExtensionsModel{
+			ACLEnabled:              types.BoolValue(true),
+			DNSEnabled:              types.BoolValue(true),
+			DNSZones:                types.ListValueMust(types.StringType, []attr.Value{types.StringValue("example.com")}),
+			ObservabilityEnabled:    types.BoolValue(true),
+			ObservabilityInstanceId: types.StringValue("oid"),
+		},
+	}
+	dev := clustersItemModel{
+		Name:                  types.StringValue("dev"),
+		KubernetesVersionUsed: types.StringNull(),
+		Status:                types.StringValue("STATE_UNHEALTHY"),
+		Hibernated:            types.BoolNull(),
+		CreationTime:          types.StringNull(),
+		EgressAddressRanges:   types.ListNull(types.StringType),
+		NodePools:             []clustersNodePoolModel{},
+		Extensions:            prodA.Extensions,
+	}
+
+	tests := []struct {
+		description string
+		input       *ske.ListClustersResponse
+		nameRegex   types.String
+		statuses    types.List
+		expected    []clustersItemModel
+		isValid     bool
+	}{
+		{
+			"all_clusters",
+			clusters,
+			types.StringNull(),
+			types.ListNull(types.StringType),
+			[]clustersItemModel{dev, prodA, prodB},
+			true,
+		},
+		{
+			"empty_response",
+			&ske.ListClustersResponse{},
+			types.StringNull(),
+			types.ListNull(types.StringType),
+			[]clustersItemModel{},
+			true,
+		},
+		{
+			"name_regex",
+			clusters,
+			types.StringValue("^prod-"),
+			types.ListNull(types.StringType),
+			[]clustersItemModel{prodA, prodB},
+			true,
+		},
+		{
+			"statuses",
+			clusters,
+			types.StringNull(),
+			types.ListValueMust(types.StringType, []attr.Value{
+				types.StringValue("STATE_HEALTHY"),
+				types.StringValue("STATE_UNHEALTHY"),
+			}),
+			[]clustersItemModel{dev, prodA},
+			true,
+		},
+		{
+			"name_regex_and_statuses",
+			clusters,
+			types.StringValue("^prod-"),
+			types.ListValueMust(types.StringType, []attr.Value{types.StringValue("STATE_HEALTHY")}),
+			[]clustersItemModel{prodA},
+			true,
+		},
+		{
+			"invalid_name_regex",
+			clusters,
+			types.StringValue("prod-("),
+			types.ListNull(types.StringType),
+			nil,
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			types.StringNull(),
+			types.ListNull(types.StringType),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &clustersDataSourceModel{
+				ProjectId: types.StringValue("pid"),
+				NameRegex: tt.nameRegex,
+				Statuses:  tt.statuses,
+			}
+			err := mapClustersFields(context.Background(), tt.input, model, testRegion)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				expected := &clustersDataSourceModel{
+					Id:        types.StringValue("pid," + testRegion),
+					ProjectId: types.StringValue("pid"),
+					Region:    types.StringValue(testRegion),
+					NameRegex: tt.nameRegex,
+					Statuses:  tt.statuses,
+					Items:     tt.expected,
+				}
+				diff := cmp.Diff(model, expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
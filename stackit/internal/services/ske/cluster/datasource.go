@@ -317,6 +317,42 @@ func (r *clusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 				Optional:    true,
 				Description: "The resource region. If not defined, the provider region is used.",
 			},
+			"status": schema.StringAttribute{
+				Description: "The aggregated status of the cluster, e.g. `STATE_HEALTHY` or `STATE_CREATING`.",
+				Computed:    true,
+			},
+			"errors": schema.ListNestedAttribute{
+				Description: "Errors reported by SKE for the cluster, e.g. a DNS zone of the DNS extension that can't be found.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: "The error code, e.g. `SKE_DNS_ZONE_NOT_FOUND`.",
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: "The error message.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"hibernated": schema.BoolAttribute{
+				Description: "Whether the cluster is hibernated.",
+				Computed:    true,
+			},
+			"creation_time": schema.StringAttribute{
+				Description: "Date-time when the cluster was created.",
+				Computed:    true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: "If set to `true`, reading the data source waits until the cluster is ready. Can be used to wait for a cluster created with `wait_for_ready = false`. Defaults to `false`.",
+				Optional:    true,
+			},
+			"ignore_external_node_pools": schema.BoolAttribute{
+				Description: "Not used by the data source, `node_pools` lists all node pools of the cluster.",
+				Computed:    true,
+			},
 		},
 	}
 }
@@ -336,7 +372,15 @@ func (r *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	ctx = tflog.SetField(ctx, "project_id", projectId)
 	ctx = tflog.SetField(ctx, "name", name)
 	ctx = tflog.SetField(ctx, "region", region)
-	clusterResp, err := r.client.GetCluster(ctx, projectId, region, name).Execute()
+	var clusterResp *ske.Cluster
+	var err error
+	if state.WaitForReady.ValueBool() {
+		waitCtx := core.InitWaitProgress(ctx, "cluster")
+		clusterResp, err = clusterReadyWaitHandler(waitCtx, r.client, projectId, region, name).WaitWithContext(waitCtx)
+		err = core.WrapWaitError(waitCtx, err)
+	} else {
+		clusterResp, err = r.client.GetCluster(ctx, projectId, region, name).Execute()
+	}
 	if err != nil {
 		utils.LogError(
 			ctx,
@@ -0,0 +1,159 @@
+package ske
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	dnsSdk "github.com/stackitcloud/stackit-sdk-go/services/dns"
+	observabilitySdk "github.com/stackitcloud/stackit-sdk-go/services/observability"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// readyObservabilityInstanceStates are the states of an observability instance which can be used by the observability extension
+var readyObservabilityInstanceStates = []string{
+	string(observabilitySdk.GETINSTANCERESPONSESTATUS_CREATE_SUCCEEDED),
+	string(observabilitySdk.GETINSTANCERESPONSESTATUS_UPDATING),
+	string(observabilitySdk.GETINSTANCERESPONSESTATUS_UPDATE_SUCCEEDED),
+}
+
+// deletedDNSZoneStates are the states of a DNS zone which can't be used by the DNS extension
+var deletedDNSZoneStates = []dnsSdk.ZoneState{
+	dnsSdk.ZONESTATE_DELETING,
+	dnsSdk.ZONESTATE_DELETE_SUCCEEDED,
+}
+
+// extensionReferencesClient looks up the resources referenced by the cluster extensions
+type extensionReferencesClient interface {
+	dnsZoneExists(ctx context.Context, projectId, dnsName string) (bool, error)
+	getObservabilityInstance(ctx context.Context, projectId, instanceId string) (*observabilitySdk.GetInstanceResponse, error)
+}
+
+type extensionReferencesAPIClient struct {
+	dnsClient           *dnsSdk.APIClient
+	observabilityClient *observabilitySdk.APIClient
+}
+
+var _ extensionReferencesClient = &extensionReferencesAPIClient{}
+
+func (c *extensionReferencesAPIClient) dnsZoneExists(ctx context.Context, projectId, dnsName string) (bool, error) {
+	zonesResp, err := c.dnsClient.ListZones(ctx, projectId).DnsNameEq(dnsName).Execute()
+	if err != nil {
+		return false, err
+	}
+	for _, zone := range zonesResp.GetZones() {
+		if normalizeDNSName(zone.GetDnsName()) == normalizeDNSName(dnsName) && !slices.Contains(deletedDNSZoneStates, zone.GetState()) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (c *extensionReferencesAPIClient) getObservabilityInstance(ctx context.Context, projectId, instanceId string) (*observabilitySdk.GetInstanceResponse, error) {
+	return c.observabilityClient.GetInstanceExecute(ctx, instanceId, projectId)
+}
+
+func normalizeDNSName(dnsName string) string {
+	return strings.TrimSuffix(dnsName, ".")
+}
+
+// validateExtensionReferences checks that the DNS zones of the DNS extension exist in the project and that the
+// instance of the observability extension exists and is ready. Unknown values are skipped, e.g. zones and instances
+// created in the same apply. Only references that differ from the state (nil on create) are checked, so existing
+// clusters don't look them up on every plan. Failing lookups only log a warning, since they might be caused by missing
+// permissions for the DNS or observability API.
+func validateExtensionReferences(ctx context.Context, diags *diag.Diagnostics, model, state *Model, client extensionReferencesClient) {
+	if utils.IsUndefined(model.Extensions) || utils.IsUndefined(model.ProjectId) {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+
+	dnsExtension, observabilityExtension := extensionReferences(ctx, diags, model)
+	if diags.HasError() {
+		return
+	}
+	stateDNSExtension, stateObservabilityExtension := &dns{}, &observability{}
+	if state != nil {
+		stateDNSExtension, stateObservabilityExtension = extensionReferences(ctx, diags, state)
+		if diags.HasError() {
+			return
+		}
+	}
+
+	dnsChanged := !dnsExtension.Enabled.Equal(stateDNSExtension.Enabled) || !dnsExtension.Zones.Equal(stateDNSExtension.Zones)
+	if dnsChanged && dnsExtension.Enabled.ValueBool() && !utils.IsUndefined(dnsExtension.Zones) {
+		zones := []basetypes.StringValue{}
+		diags.Append(dnsExtension.Zones.ElementsAs(ctx, &zones, true)...)
+		if diags.HasError() {
+			return
+		}
+		for _, zone := range zones {
+			if utils.IsUndefined(zone) {
+				continue
+			}
+			exists, err := client.dnsZoneExists(ctx, projectId, zone.ValueString())
+			if err != nil {
+				tflog.Warn(ctx, fmt.Sprintf("Could not check the DNS zone %q of the DNS extension: %v", zone.ValueString(), err))
+				continue
+			}
+			if !exists {
+				core.LogAndAddError(ctx, diags, "Invalid DNS extension", fmt.Sprintf("The DNS zone %q does not exist in project %q. Create it with a `stackit_dns_zone` resource and reference its `dns_name`.", zone.ValueString(), projectId))
+			}
+		}
+	}
+
+	observabilityChanged := !observabilityExtension.Enabled.Equal(stateObservabilityExtension.Enabled) || !observabilityExtension.InstanceId.Equal(stateObservabilityExtension.InstanceId)
+	if observabilityChanged && observabilityExtension.Enabled.ValueBool() && !utils.IsUndefined(observabilityExtension.InstanceId) {
+		validateObservabilityInstance(ctx, diags, client, projectId, observabilityExtension.InstanceId.ValueString())
+	}
+}
+
+// extensionReferences returns the DNS and observability extensions of the model. Extensions which are not set are empty.
+func extensionReferences(ctx context.Context, diags *diag.Diagnostics, model *Model) (*dns, *observability) {
+	dnsExtension, observabilityExtension := &dns{}, &observability{}
+	if utils.IsUndefined(model.Extensions) {
+		return dnsExtension, observabilityExtension
+	}
+	ex := &extensions{}
+	diags.Append(model.Extensions.As(ctx, ex, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return dnsExtension, observabilityExtension
+	}
+	if !utils.IsUndefined(ex.DNS) {
+		diags.Append(ex.DNS.As(ctx, dnsExtension, basetypes.ObjectAsOptions{})...)
+	}
+	if !utils.IsUndefined(ex.Observability) {
+		diags.Append(ex.Observability.As(ctx, observabilityExtension, basetypes.ObjectAsOptions{})...)
+	}
+	return dnsExtension, observabilityExtension
+}
+
+func validateObservabilityInstance(ctx context.Context, diags *diag.Diagnostics, client extensionReferencesClient, projectId, instanceId string) {
+	instance, err := client.getObservabilityInstance(ctx, projectId, instanceId)
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			core.LogAndAddError(ctx, diags, "Invalid observability extension", fmt.Sprintf("The observability instance %q does not exist in project %q.", instanceId, projectId))
+			return
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Could not check the observability instance %q of the observability extension: %v", instanceId, err))
+		return
+	}
+	status := string(instance.GetStatus())
+	// A failed update doesn't make the instance unusable, the previous configuration stays active
+	if status == string(observabilitySdk.GETINSTANCERESPONSESTATUS_UPDATE_FAILED) {
+		core.LogAndAddWarning(ctx, diags, "Observability extension instance", fmt.Sprintf("The last update of the observability instance %q failed, its status is %q.", instanceId, status))
+		return
+	}
+	if !slices.Contains(readyObservabilityInstanceStates, status) {
+		core.LogAndAddError(ctx, diags, "Invalid observability extension", fmt.Sprintf("The observability instance %q is not ready, its status is %q.", instanceId, status))
+	}
+}
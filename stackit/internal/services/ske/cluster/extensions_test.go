@@ -0,0 +1,259 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	observabilitySdk "github.com/stackitcloud/stackit-sdk-go/services/observability"
+)
+
+type extensionReferencesClientMocked struct {
+	zones             []string
+	instances         map[string]observabilitySdk.GetInstanceResponseStatus
+	returnError       bool
+	lookedUpZones     []string
+	lookedUpInstances []string
+}
+
+func (c *extensionReferencesClientMocked) dnsZoneExists(_ context.Context, _, dnsName string) (bool, error) {
+	c.lookedUpZones = append(c.lookedUpZones, dnsName)
+	if c.returnError {
+		return false, fmt.Errorf("list zones failed")
+	}
+	return slices.Contains(c.zones, dnsName), nil
+}
+
+func (c *extensionReferencesClientMocked) getObservabilityInstance(_ context.Context, _, instanceId string) (*observabilitySdk.GetInstanceResponse, error) {
+	c.lookedUpInstances = append(c.lookedUpInstances, instanceId)
+	if c.returnError {
+		return nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusForbidden}
+	}
+	status, ok := c.instances[instanceId]
+	if !ok {
+		return nil, &oapierror.GenericOpenAPIError{StatusCode: http.StatusNotFound}
+	}
+	return &observabilitySdk.GetInstanceResponse{
+		Id:     &instanceId,
+		Status: &status,
+	}, nil
+}
+
+func TestValidateExtensionReferences(t *testing.T) {
+	extensionsValue := func(dnsValue, observabilityValue types.Object) types.Object {
+		return types.ObjectValueMust(extensionsTypes, map[string]attr.Value{
+			"acl":           types.ObjectNull(aclTypes),
+			"argus":         types.ObjectNull(argusTypes),
+			"dns":           dnsValue,
+			"observability": observabilityValue,
+		})
+	}
+	dnsValue := func(enabled bool, zones ...attr.Value) types.Object {
+		return types.ObjectValueMust(dnsTypes, map[string]attr.Value{
+			"enabled": types.BoolValue(enabled),
+			"zones":   types.ListValueMust(types.StringType, zones),
+		})
+	}
+	observabilityValue := func(enabled bool, instanceId types.String) types.Object {
+		return types.ObjectValueMust(observabilityTypes, map[string]attr.Value{
+			"enabled":     types.BoolValue(enabled),
+			"instance_id": instanceId,
+		})
+	}
+
+	tests := []struct {
+		description       string
+		extensions        types.Object
+		returnError       bool
+		expectedZones     []string
+		expectedInstances []string
+		inState           bool
+		isValid           bool
+		hasWarning        bool
+	}{
+		{
+			"no_extensions",
+			types.ObjectNull(extensionsTypes),
+			false,
+			nil,
+			nil,
+			false,
+			true,
+			false,
+		},
+		{
+			"existing_references",
+			extensionsValue(
+				dnsValue(true, types.StringValue("foo.runs.onstackit.cloud")),
+				observabilityValue(true, types.StringValue("ready")),
+			),
+			false,
+			[]string{"foo.runs.onstackit.cloud"},
+			[]string{"ready"},
+			false,
+			true,
+			false,
+		},
+		{
+			"missing_dns_zone",
+			extensionsValue(
+				dnsValue(true, types.StringValue("foo.runs.onstackit.cloud"), types.StringValue("missing.runs.onstackit.cloud")),
+				types.ObjectNull(observabilityTypes),
+			),
+			false,
+			[]string{"foo.runs.onstackit.cloud", "missing.runs.onstackit.cloud"},
+			nil,
+			false,
+			false,
+			false,
+		},
+		{
+			"unknown_dns_zone_skipped",
+			extensionsValue(
+				dnsValue(true, types.StringUnknown()),
+				types.ObjectNull(observabilityTypes),
+			),
+			false,
+			nil,
+			nil,
+			false,
+			true,
+			false,
+		},
+		{
+			"disabled_extensions_skipped",
+			extensionsValue(
+				dnsValue(false, types.StringValue("missing.runs.onstackit.cloud")),
+				observabilityValue(false, types.StringValue("missing")),
+			),
+			false,
+			nil,
+			nil,
+			false,
+			true,
+			false,
+		},
+		{
+			"missing_observability_instance",
+			extensionsValue(
+				types.ObjectNull(dnsTypes),
+				observabilityValue(true, types.StringValue("missing")),
+			),
+			false,
+			nil,
+			[]string{"missing"},
+			false,
+			false,
+			false,
+		},
+		{
+			"observability_instance_not_ready",
+			extensionsValue(
+				types.ObjectNull(dnsTypes),
+				observabilityValue(true, types.StringValue("failed")),
+			),
+			false,
+			nil,
+			[]string{"failed"},
+			false,
+			false,
+			false,
+		},
+		{
+			"unknown_observability_instance_skipped",
+			extensionsValue(
+				types.ObjectNull(dnsTypes),
+				observabilityValue(true, types.StringUnknown()),
+			),
+			false,
+			nil,
+			nil,
+			false,
+			true,
+			false,
+		},
+		{
+			"lookup_errors_are_ignored",
+			extensionsValue(
+				dnsValue(true, types.StringValue("foo.runs.onstackit.cloud")),
+				observabilityValue(true, types.StringValue("ready")),
+			),
+			true,
+			[]string{"foo.runs.onstackit.cloud"},
+			[]string{"ready"},
+			false,
+			true,
+			false,
+		},
+		{
+			"unchanged_references_skipped",
+			extensionsValue(
+				dnsValue(true, types.StringValue("missing.runs.onstackit.cloud")),
+				observabilityValue(true, types.StringValue("missing")),
+			),
+			false,
+			nil,
+			nil,
+			true,
+			true,
+			false,
+		},
+		{
+			"observability_instance_update_failed",
+			extensionsValue(
+				types.ObjectNull(dnsTypes),
+				observabilityValue(true, types.StringValue("update-failed")),
+			),
+			false,
+			nil,
+			[]string{"update-failed"},
+			false,
+			true,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &extensionReferencesClientMocked{
+				zones: []string{"foo.runs.onstackit.cloud"},
+				instances: map[string]observabilitySdk.GetInstanceResponseStatus{
+					"ready":         observabilitySdk.GETINSTANCERESPONSESTATUS_CREATE_SUCCEEDED,
+					"failed":        observabilitySdk.GETINSTANCERESPONSESTATUS_CREATE_FAILED,
+					"update-failed": observabilitySdk.GETINSTANCERESPONSESTATUS_UPDATE_FAILED,
+				},
+				returnError: tt.returnError,
+			}
+			model := &Model{
+				ProjectId:  types.StringValue("pid"),
+				Extensions: tt.extensions,
+			}
+			var state *Model
+			if tt.inState {
+				state = model
+			}
+			var diags diag.Diagnostics
+			validateExtensionReferences(context.Background(), &diags, model, state, client)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if hasWarning := diags.WarningsCount() > 0; hasWarning != tt.hasWarning {
+				t.Fatalf("expected warning %t, got %v", tt.hasWarning, diags.Warnings())
+			}
+			if !slices.Equal(client.lookedUpZones, tt.expectedZones) {
+				t.Fatalf("expected zone lookups %v, got %v", tt.expectedZones, client.lookedUpZones)
+			}
+			if !slices.Equal(client.lookedUpInstances, tt.expectedInstances) {
+				t.Fatalf("expected instance lookups %v, got %v", tt.expectedInstances, client.lookedUpInstances)
+			}
+		})
+	}
+}
@@ -0,0 +1,722 @@
+package ske
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/oapierror"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// updateClusterSpecAttempts is the number of attempts to update the cluster spec if the update conflicts
+const updateClusterSpecAttempts = 5
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &nodePoolResource{}
+	_ resource.ResourceWithConfigure      = &nodePoolResource{}
+	_ resource.ResourceWithImportState    = &nodePoolResource{}
+	_ resource.ResourceWithModifyPlan     = &nodePoolResource{}
+	_ resource.ResourceWithValidateConfig = &nodePoolResource{}
+)
+
+type NodePoolModel struct {
+	Id                    types.String `tfsdk:"id"` // needed by TF
+	ProjectId             types.String `tfsdk:"project_id"`
+	Region                types.String `tfsdk:"region"`
+	ClusterName           types.String `tfsdk:"cluster_name"`
+	Name                  types.String `tfsdk:"name"`
+	MachineType           types.String `tfsdk:"machine_type"`
+	OSName                types.String `tfsdk:"os_name"`
+	OSVersionMin          types.String `tfsdk:"os_version_min"`
+	OSVersionUsed         types.String `tfsdk:"os_version_used"`
+	Minimum               types.Int64  `tfsdk:"minimum"`
+	Maximum               types.Int64  `tfsdk:"maximum"`
+	MaxSurge              types.Int64  `tfsdk:"max_surge"`
+	MaxUnavailable        types.Int64  `tfsdk:"max_unavailable"`
+	VolumeType            types.String `tfsdk:"volume_type"`
+	VolumeSize            types.Int64  `tfsdk:"volume_size"`
+	Labels                types.Map    `tfsdk:"labels"`
+	Taints                types.List   `tfsdk:"taints"`
+	CRI                   types.String `tfsdk:"cri"`
+	AvailabilityZones     types.List   `tfsdk:"availability_zones"`
+	AllowSystemComponents types.Bool   `tfsdk:"allow_system_components"`
+}
+
+// toNodePool converts the model to the node pool struct of the cluster resource
+func (m *NodePoolModel) toNodePool() nodePool {
+	return nodePool{
+		Name:                  m.Name,
+		MachineType:           m.MachineType,
+		OSName:                m.OSName,
+		OSVersionMin:          m.OSVersionMin,
+		OSVersion:             types.StringNull(),
+		OSVersionUsed:         m.OSVersionUsed,
+		Minimum:               m.Minimum,
+		Maximum:               m.Maximum,
+		MaxSurge:              m.MaxSurge,
+		MaxUnavailable:        m.MaxUnavailable,
+		VolumeType:            m.VolumeType,
+		VolumeSize:            m.VolumeSize,
+		Labels:                m.Labels,
+		Taints:                m.Taints,
+		CRI:                   m.CRI,
+		AvailabilityZones:     m.AvailabilityZones,
+		AllowSystemComponents: m.AllowSystemComponents,
+	}
+}
+
+// NewNodePoolResource is a helper function to simplify the provider implementation.
+func NewNodePoolResource() resource.Resource {
+	return &nodePoolResource{}
+}
+
+// nodePoolResource is the resource implementation.
+type nodePoolResource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *nodePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_node_pool"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *nodePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *nodePoolResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main": "SKE node pool resource schema. Manages a single node pool of an existing SKE cluster, identified by its name. " +
+			"The `stackit_ske_cluster` resource of the cluster must set `ignore_external_node_pools = true`, otherwise it removes the node pool on its next update.",
+		"id":                  "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cluster_name`,`name`\".",
+		"max_surge":           "Maximum number of additional VMs that are created during an update.",
+		"max_unavailable":     "Maximum number of VMs that that can be unavailable during an update.",
+		"nodepool_validators": "If set (larger than 0), then it must be at least the amount of zones configured for the nodepool. The `max_surge` and `max_unavailable` fields cannot both be unset at the same time.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: descriptions["main"],
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: "STACKIT project ID to which the cluster is associated.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: "The resource region. If not defined, the provider region is used.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: "Name of the SKE cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Specifies the name of the node pool.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"machine_type": schema.StringAttribute{
+				Description: "The machine type.",
+				Required:    true,
+			},
+			"availability_zones": schema.ListAttribute{
+				Description: "Specify a list of availability zones. E.g. `eu01-m`",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"allow_system_components": schema.BoolAttribute{
+				Description: "Allow system components to run on this node pool.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"minimum": schema.Int64Attribute{
+				Description: "Minimum number of nodes in the pool.",
+				Required:    true,
+			},
+			"maximum": schema.Int64Attribute{
+				Description: "Maximum number of nodes in the pool.",
+				Required:    true,
+			},
+			"max_surge": schema.Int64Attribute{
+				Description: fmt.Sprintf("%s %s", descriptions["max_surge"], descriptions["nodepool_validators"]),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_unavailable": schema.Int64Attribute{
+				Description: fmt.Sprintf("%s %s", descriptions["max_unavailable"], descriptions["nodepool_validators"]),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"os_name": schema.StringAttribute{
+				Description: "The name of the OS image. Defaults to `flatcar`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultOSName),
+			},
+			"os_version_min": schema.StringAttribute{
+				Description: "The minimum OS image version. This field will be used to set the minimum OS image version on creation/update of the node pool. If unset, the latest supported OS image version will be used. " + SKEUpdateDoc + " To get the current OS image version being used for the node pool, use the read-only `os_version_used` field.",
+				Optional:    true,
+				Validators: []validator.String{
+					validate.VersionNumber(),
+				},
+			},
+			"os_version_used": schema.StringAttribute{
+				Description: "Full OS image version used. For example, if 3815.2 was set in `os_version_min`, this value may result to 3815.2.2. " + SKEUpdateDoc,
+				Computed:    true,
+			},
+			"volume_type": schema.StringAttribute{
+				Description: "Specifies the volume type. Defaults to `storage_premium_perf1`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultVolumeType),
+			},
+			"volume_size": schema.Int64Attribute{
+				Description: "The volume size in GB. Defaults to `20`",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(DefaultVolumeSizeGB),
+			},
+			"labels": schema.MapAttribute{
+				Description: "Labels to add to each node.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"taints": schema.ListNestedAttribute{
+				Description: "Specifies a taint list as defined below.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"effect": schema.StringAttribute{
+							Description: fmt.Sprintf("The taint effect. %s", utils.FormatPossibleValues(taintEffects...)),
+							Required:    true,
+						},
+						"key": schema.StringAttribute{
+							Description: "Taint key to be applied to a node.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"value": schema.StringAttribute{
+							Description: "Taint value corresponding to the taint key.",
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"cri": schema.StringAttribute{
+				Description: "Specifies the container runtime. Defaults to `containerd`",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(DefaultCRI),
+			},
+		},
+	}
+}
+
+// ValidateConfig validates labels, taints and the update settings of the node pool.
+func (r *nodePoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model NodePoolModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	np := model.toNodePool()
+	validateNodePool(ctx, &resp.Diagnostics, &np)
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan and to validate the node pool against the SKE provider options.
+func (r *nodePoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel NodePoolModel
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel NodePoolModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.Plan.Raw.IsNull() && !utils.IsUndefined(planModel.Region) {
+		region := planModel.Region.ValueString()
+		providerOptions, err := providerOptionsCache.Get(region, func() (*ske.ProviderOptions, error) {
+			return r.client.ListProviderOptions(ctx, region).Execute()
+		})
+		if err != nil {
+			utils.LogCatalogError(ctx, "SKE provider options", err)
+		} else {
+			stateNodePools := []nodePool{}
+			if !req.State.Raw.IsNull() {
+				var stateModel NodePoolModel
+				resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				stateNodePools = append(stateNodePools, stateModel.toNodePool())
+			}
+			validateNodePoolProviderOptions(ctx, &resp.Diagnostics, []nodePool{configModel.toNodePool()}, stateNodePools, providerOptions)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *nodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = setNodePoolLogFields(ctx, &model)
+
+	r.applyNodePool(ctx, &resp.Diagnostics, &model, true)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE node pool created")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *nodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	model.Region = types.StringValue(region)
+	ctx = setNodePoolLogFields(ctx, &model)
+
+	cluster, err := r.client.GetClusterExecute(ctx, model.ProjectId.ValueString(), region, model.ClusterName.ValueString())
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading node pool", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	if findNodePool(cluster.GetNodepools(), model.Name.ValueString()) == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapNodePoolFields(ctx, cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading node pool", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE node pool read")
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *nodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = setNodePoolLogFields(ctx, &model)
+
+	r.applyNodePool(ctx, &resp.Diagnostics, &model, false)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE node pool updated")
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *nodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model NodePoolModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = setNodePoolLogFields(ctx, &model)
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	name := model.Name.ValueString()
+
+	unlock := skeUtils.LockCluster(projectId, region, clusterName)
+	defer unlock()
+
+	removed := false
+	_, err := updateClusterSpec(ctx, r.client, projectId, region, clusterName, func(cluster *ske.Cluster) (*ske.CreateOrUpdateClusterPayload, error) {
+		nodePools, found := removeNodePool(cluster.GetNodepools(), name)
+		removed = found
+		if !found {
+			return nil, nil
+		}
+		payload := toClusterPayload(cluster)
+		payload.Nodepools = &nodePools
+		return payload, nil
+	})
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			tflog.Info(ctx, "SKE cluster not found, node pool already deleted")
+			return
+		}
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting node pool", fmt.Sprintf("Updating cluster: %v", err))
+		return
+	}
+	if !removed {
+		tflog.Info(ctx, "SKE node pool not found, already deleted")
+		return
+	}
+
+	waitCtx := core.InitWaitProgress(ctx, "cluster")
+	_, err = clusterReadyWaitHandler(waitCtx, r.client, projectId, region, clusterName).WaitWithContext(waitCtx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting node pool", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Cluster update waiting: %v", err)))
+		return
+	}
+	tflog.Info(ctx, "SKE node pool deleted")
+}
+
+// ImportState imports a resource into the Terraform state on success.
+// The expected format of the resource import identifier is: project_id,region,cluster_name,name
+func (r *nodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, core.Separator)
+	if len(idParts) != 4 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" || idParts[3] == "" {
+		core.LogAndAddError(ctx, &resp.Diagnostics,
+			"Error importing node pool",
+			fmt.Sprintf("Expected import identifier with format: [project_id],[region],[cluster_name],[name]  Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), idParts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_name"), idParts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), idParts[3])...)
+	tflog.Info(ctx, "SKE node pool state imported")
+}
+
+// applyNodePool adds the node pool to the cluster spec (or replaces it), waits for the cluster to be ready and maps the result to the model.
+// If create is true, it fails if a node pool with the same name exists already.
+func (r *nodePoolResource) applyNodePool(ctx context.Context, diags *diag.Diagnostics, model *NodePoolModel, create bool) {
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	name := model.Name.ValueString()
+
+	_, availableMachines, err := loadAvailableVersions(ctx, r.client, region)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Loading available machine image versions: %v", err))
+		return
+	}
+
+	unlock := skeUtils.LockCluster(projectId, region, clusterName)
+	defer unlock()
+
+	var deprecatedVersion *string
+	_, err = updateClusterSpec(ctx, r.client, projectId, region, clusterName, func(cluster *ske.Cluster) (*ske.CreateOrUpdateClusterPayload, error) {
+		var currentImage *ske.Image
+		if current := findNodePool(cluster.GetNodepools(), name); current != nil {
+			if create {
+				return nil, fmt.Errorf("node pool %q exists already in cluster %q, import it to manage it with Terraform", name, clusterName)
+			}
+			if current.Machine != nil {
+				currentImage = current.Machine.Image
+			}
+		}
+		np := model.toNodePool()
+		nodePoolPayload, deprecated, err := toNodepoolPayload(ctx, &np, availableMachines, currentImage)
+		if err != nil {
+			return nil, fmt.Errorf("creating node pool API payload: %w", err)
+		}
+		deprecatedVersion = deprecated
+		nodePools := setNodePool(cluster.GetNodepools(), nodePoolPayload)
+		payload := toClusterPayload(cluster)
+		payload.Nodepools = &nodePools
+		return payload, nil
+	})
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Updating cluster: %v", err))
+		return
+	}
+	if deprecatedVersion != nil {
+		diags.AddWarning("Deprecated node pool OS version used", fmt.Sprintf("Version %s of the machine image is deprecated, please update it", *deprecatedVersion))
+	}
+
+	waitCtx := core.InitWaitProgress(ctx, "cluster")
+	cluster, err := clusterReadyWaitHandler(waitCtx, r.client, projectId, region, clusterName).WaitWithContext(waitCtx)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Cluster update waiting: %v", err)))
+		return
+	}
+
+	err = mapNodePoolFields(ctx, cluster, model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating node pool", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+}
+
+// updateClusterSpec reads the cluster spec, modifies it and writes it back. If modify returns a nil payload, nothing is written.
+// Conflicting updates, e.g. while the cluster is reconciling, are retried once the cluster is ready again.
+func updateClusterSpec(ctx context.Context, client *ske.APIClient, projectId, region, name string, modify func(*ske.Cluster) (*ske.CreateOrUpdateClusterPayload, error)) (*ske.Cluster, error) {
+	for attempt := 1; ; attempt++ {
+		cluster, err := client.GetClusterExecute(ctx, projectId, region, name)
+		if err != nil {
+			return nil, fmt.Errorf("reading cluster: %w", err)
+		}
+		payload, err := modify(cluster)
+		if err != nil {
+			return nil, err
+		}
+		if payload == nil {
+			return cluster, nil
+		}
+
+		updatedCluster, err := client.CreateOrUpdateCluster(ctx, projectId, region, name).CreateOrUpdateClusterPayload(*payload).Execute()
+		if err == nil {
+			return updatedCluster, nil
+		}
+		if !isConflict(err) || attempt >= updateClusterSpecAttempts {
+			return nil, fmt.Errorf("calling API: %w", err)
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Conflicting cluster update, retrying once the cluster is ready (attempt %d of %d)", attempt, updateClusterSpecAttempts))
+		waitCtx := core.InitWaitProgress(ctx, "cluster")
+		_, err = clusterReadyWaitHandler(waitCtx, client, projectId, region, name).WaitWithContext(waitCtx)
+		if err != nil {
+			return nil, core.WrapWaitError(waitCtx, fmt.Errorf("waiting for the cluster before retrying the update: %w", err))
+		}
+	}
+}
+
+func isConflict(err error) bool {
+	var oapiErr *oapierror.GenericOpenAPIError
+	return errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusConflict
+}
+
+// toClusterPayload creates an update payload with the current spec of the cluster
+func toClusterPayload(cluster *ske.Cluster) *ske.CreateOrUpdateClusterPayload {
+	nodePools := append([]ske.Nodepool{}, cluster.GetNodepools()...)
+	return &ske.CreateOrUpdateClusterPayload{
+		Extensions:  cluster.Extensions,
+		Hibernation: cluster.Hibernation,
+		Kubernetes:  cluster.Kubernetes,
+		Maintenance: cluster.Maintenance,
+		Network:     cluster.Network,
+		Nodepools:   &nodePools,
+	}
+}
+
+func findNodePool(nodePools []ske.Nodepool, name string) *ske.Nodepool {
+	for i := range nodePools {
+		if nodePools[i].GetName() == name {
+			return &nodePools[i]
+		}
+	}
+	return nil
+}
+
+// setNodePool replaces the node pool with the same name, or appends it if there is none
+func setNodePool(nodePools []ske.Nodepool, nodePool *ske.Nodepool) []ske.Nodepool {
+	result := []ske.Nodepool{}
+	found := false
+	for i := range nodePools {
+		if nodePools[i].GetName() == nodePool.GetName() {
+			result = append(result, *nodePool)
+			found = true
+			continue
+		}
+		result = append(result, nodePools[i])
+	}
+	if !found {
+		result = append(result, *nodePool)
+	}
+	return result
+}
+
+// removeNodePool removes the node pool with the given name, returning whether it was found
+func removeNodePool(nodePools []ske.Nodepool, name string) ([]ske.Nodepool, bool) {
+	result := []ske.Nodepool{}
+	found := false
+	for i := range nodePools {
+		if nodePools[i].GetName() == name {
+			found = true
+			continue
+		}
+		result = append(result, nodePools[i])
+	}
+	return result, found
+}
+
+func setNodePoolLogFields(ctx context.Context, model *NodePoolModel) context.Context {
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "region", model.Region.ValueString())
+	ctx = tflog.SetField(ctx, "cluster_name", model.ClusterName.ValueString())
+	return tflog.SetField(ctx, "name", model.Name.ValueString())
+}
+
+func mapNodePoolFields(ctx context.Context, cluster *ske.Cluster, model *NodePoolModel, region string) error {
+	if cluster == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+	name := model.Name.ValueString()
+	nodePoolResp := findNodePool(cluster.GetNodepools(), name)
+	if nodePoolResp == nil {
+		return fmt.Errorf("node pool %q not found in cluster", name)
+	}
+
+	taintsInModel := !model.Taints.IsNull() && !model.Taints.IsUnknown()
+	nodePoolTF, err := mapNodePool(ctx, nodePoolResp, types.StringNull(), model.OSVersionMin, taintsInModel)
+	if err != nil {
+		return err
+	}
+	var np nodePool
+	diags := nodePoolTF.As(ctx, &np, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ClusterName.ValueString(), name)
+	model.Region = types.StringValue(region)
+	model.MachineType = np.MachineType
+	model.OSName = np.OSName
+	model.OSVersionUsed = np.OSVersionUsed
+	model.Minimum = np.Minimum
+	model.Maximum = np.Maximum
+	model.MaxSurge = np.MaxSurge
+	model.MaxUnavailable = np.MaxUnavailable
+	model.VolumeType = np.VolumeType
+	model.VolumeSize = np.VolumeSize
+	model.Labels = np.Labels
+	model.Taints = np.Taints
+	model.CRI = np.CRI
+	model.AvailabilityZones = np.AvailabilityZones
+	model.AllowSystemComponents = np.AllowSystemComponents
+	return nil
+}
@@ -0,0 +1,273 @@
+package ske
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapNodePoolFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		expected    NodePoolModel
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&ske.Cluster{
+				Nodepools: &[]ske.Nodepool{
+					{
+						Name: utils.Ptr("other"),
+						Machine: &ske.Machine{
+							Type: utils.Ptr("c1.2"),
+						},
+						Volume: &ske.Volume{},
+					},
+					{
+						Name: utils.Ptr("np"),
+						Machine: &ske.Machine{
+							Type: utils.Ptr("g1.2"),
+							Image: &ske.Image{
+								Name:    utils.Ptr("flatcar"),
+								Version: utils.Ptr("3815.2.5"),
+							},
+						},
+						Minimum:               utils.Ptr(int64(1)),
+						Maximum:               utils.Ptr(int64(3)),
+						MaxSurge:              utils.Ptr(int64(1)),
+						MaxUnavailable:        utils.Ptr(int64(0)),
+						AllowSystemComponents: utils.Ptr(true),
+						Volume: &ske.Volume{
+							Type: utils.Ptr("storage_premium_perf1"),
+							Size: utils.Ptr(int64(20)),
+						},
+						Labels: &map[string]string{
+							"k": "v",
+						},
+						Taints: &[]ske.Taint{
+							{
+								Effect: ske.TAINTEFFECT_NO_SCHEDULE.Ptr(),
+								Key:    utils.Ptr("key"),
+								Value:  utils.Ptr("value"),
+							},
+						},
+						Cri: &ske.CRI{
+							Name: ske.CRINAME_CONTAINERD.Ptr(),
+						},
+						AvailabilityZones: &[]string{"eu01-1"},
+					},
+				},
+			},
+			NodePoolModel{
+				Id:                    types.StringValue("pid,eu01,cluster,np"),
+				ProjectId:             types.StringValue("pid"),
+				Region:                types.StringValue("eu01"),
+				ClusterName:           types.StringValue("cluster"),
+				Name:                  types.StringValue("np"),
+				MachineType:           types.StringValue("g1.2"),
+				OSName:                types.StringValue("flatcar"),
+				OSVersionMin:          types.StringValue("3815.2"),
+				OSVersionUsed:         types.StringValue("3815.2.5"),
+				Minimum:               types.Int64Value(1),
+				Maximum:               types.Int64Value(3),
+				MaxSurge:              types.Int64Value(1),
+				MaxUnavailable:        types.Int64Value(0),
+				VolumeType:            types.StringValue("storage_premium_perf1"),
+				VolumeSize:            types.Int64Value(20),
+				Labels:                types.MapValueMust(types.StringType, map[string]attr.Value{"k": types.StringValue("v")}),
+				CRI:                   types.StringValue("containerd"),
+				AvailabilityZones:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1")}),
+				AllowSystemComponents: types.BoolValue(true),
+				Taints: types.ListValueMust(types.ObjectType{AttrTypes: taintTypes}, []attr.Value{
+					types.ObjectValueMust(taintTypes, map[string]attr.Value{
+						"effect": types.StringValue(string(ske.TAINTEFFECT_NO_SCHEDULE)),
+						"key":    types.StringValue("key"),
+						"value":  types.StringValue("value"),
+					}),
+				}),
+			},
+			true,
+		},
+		{
+			"node_pool_not_found",
+			&ske.Cluster{
+				Nodepools: &[]ske.Nodepool{
+					{
+						Name: utils.Ptr("other"),
+					},
+				},
+			},
+			NodePoolModel{},
+			false,
+		},
+		{
+			"nil_response",
+			nil,
+			NodePoolModel{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &NodePoolModel{
+				ProjectId:    types.StringValue("pid"),
+				ClusterName:  types.StringValue("cluster"),
+				Name:         types.StringValue("np"),
+				OSVersionMin: types.StringValue("3815.2"),
+				Taints:       types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+			}
+			err := mapNodePoolFields(context.Background(), tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSetNodePool(t *testing.T) {
+	tests := []struct {
+		description string
+		input       []ske.Nodepool
+		nodePool    *ske.Nodepool
+		expected    []ske.Nodepool
+	}{
+		{
+			"append",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+			},
+			&ske.Nodepool{Name: utils.Ptr("b"), Minimum: utils.Ptr(int64(1))},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+				{Name: utils.Ptr("b"), Minimum: utils.Ptr(int64(1))},
+			},
+		},
+		{
+			"replace",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+				{Name: utils.Ptr("b")},
+				{Name: utils.Ptr("c")},
+			},
+			&ske.Nodepool{Name: utils.Ptr("b"), Minimum: utils.Ptr(int64(1))},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+				{Name: utils.Ptr("b"), Minimum: utils.Ptr(int64(1))},
+				{Name: utils.Ptr("c")},
+			},
+		},
+		{
+			"empty",
+			nil,
+			&ske.Nodepool{Name: utils.Ptr("a")},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output := setNodePool(tt.input, tt.nodePool)
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveNodePool(t *testing.T) {
+	tests := []struct {
+		description   string
+		input         []ske.Nodepool
+		name          string
+		expected      []ske.Nodepool
+		expectedFound bool
+	}{
+		{
+			"found",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+				{Name: utils.Ptr("b")},
+			},
+			"a",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("b")},
+			},
+			true,
+		},
+		{
+			"not_found",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+			},
+			"b",
+			[]ske.Nodepool{
+				{Name: utils.Ptr("a")},
+			},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			output, found := removeNodePool(tt.input, tt.name)
+			if found != tt.expectedFound {
+				t.Fatalf("expected found to be %t", tt.expectedFound)
+			}
+			diff := cmp.Diff(output, tt.expected)
+			if diff != "" {
+				t.Fatalf("Data does not match: %s", diff)
+			}
+		})
+	}
+}
+
+func TestToClusterPayload(t *testing.T) {
+	cluster := &ske.Cluster{
+		Name: utils.Ptr("cluster"),
+		Kubernetes: &ske.Kubernetes{
+			Version: utils.Ptr("1.31.1"),
+		},
+		Nodepools: &[]ske.Nodepool{
+			{Name: utils.Ptr("a")},
+		},
+		Status: &ske.ClusterStatus{
+			Aggregated: ske.CLUSTERSTATUSSTATE_HEALTHY.Ptr(),
+		},
+	}
+	expected := &ske.CreateOrUpdateClusterPayload{
+		Kubernetes: &ske.Kubernetes{
+			Version: utils.Ptr("1.31.1"),
+		},
+		Nodepools: &[]ske.Nodepool{
+			{Name: utils.Ptr("a")},
+		},
+	}
+
+	output := toClusterPayload(cluster)
+	diff := cmp.Diff(output, expected)
+	if diff != "" {
+		t.Fatalf("Data does not match: %s", diff)
+	}
+
+	// the node pools of the payload must not share the backing array with the cluster
+	(*output.Nodepools)[0].Name = utils.Ptr("b")
+	if cluster.GetNodepools()[0].GetName() != "a" {
+		t.Fatalf("cluster node pools were modified")
+	}
+}
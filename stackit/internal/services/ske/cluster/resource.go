@@ -2,6 +2,7 @@ package ske
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
@@ -9,6 +10,8 @@ import (
 	"strings"
 	"time"
 
+	dnsUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/dns/utils"
+	observabilityUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/utils"
 	serviceenablementUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceenablement/utils"
 	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
 
@@ -49,9 +52,9 @@ const (
 	DefaultCRI                   = "containerd"
 	DefaultVolumeType            = "storage_premium_perf1"
 	DefaultVolumeSizeGB    int64 = 20
-	VersionStateSupported        = "supported"
-	VersionStatePreview          = "preview"
-	VersionStateDeprecated       = "deprecated"
+	VersionStateSupported        = skeUtils.VersionStateSupported
+	VersionStatePreview          = skeUtils.VersionStatePreview
+	VersionStateDeprecated       = skeUtils.VersionStateDeprecated
 
 	SKEUpdateDoc = "SKE automatically updates the cluster Kubernetes version if you have set `maintenance.enable_kubernetes_version_updates` to true or if there is a mandatory update, as described in [Updates for Kubernetes versions and Operating System versions in SKE](https://docs.stackit.cloud/stackit/en/version-updates-in-ske-10125631.html)."
 )
@@ -64,6 +67,9 @@ var (
 	_ resource.ResourceWithModifyPlan  = &clusterResource{}
 )
 
+// providerOptionsCache caches the SKE provider options (versions, machine types, ...) per region
+var providerOptionsCache utils.CatalogCache[*ske.ProviderOptions]
+
 type skeClient interface {
 	GetClusterExecute(ctx context.Context, projectId, region, clusterName string) (*ske.Cluster, error)
 }
@@ -82,6 +88,25 @@ type Model struct {
 	EgressAddressRanges   types.List   `tfsdk:"egress_address_ranges"`
 	PodAddressRanges      types.List   `tfsdk:"pod_address_ranges"`
 	Region                types.String `tfsdk:"region"`
+	Status                types.String `tfsdk:"status"`
+	Errors                types.List   `tfsdk:"errors"`
+	Hibernated            types.Bool   `tfsdk:"hibernated"`
+	CreationTime          types.String `tfsdk:"creation_time"`
+	WaitForReady          types.Bool   `tfsdk:"wait_for_ready"`
+	// IgnoreExternalNodePools keeps node pools managed outside of this resource, e.g. by stackit_ske_node_pool
+	IgnoreExternalNodePools types.Bool `tfsdk:"ignore_external_node_pools"`
+}
+
+// Struct corresponding to Model.Errors[i]
+type clusterError struct {
+	Code    types.String `tfsdk:"code"`
+	Message types.String `tfsdk:"message"`
+}
+
+// Types corresponding to clusterError
+var clusterErrorTypes = map[string]attr.Type{
+	"code":    basetypes.StringType{},
+	"message": basetypes.StringType{},
 }
 
 // Struct corresponding to Model.NodePools[i]
@@ -251,13 +276,14 @@ func NewClusterResource() resource.Resource {
 
 // clusterResource is the resource implementation.
 type clusterResource struct {
-	skeClient        *ske.APIClient
-	enablementClient *serviceenablement.APIClient
-	providerData     core.ProviderData
+	skeClient           *ske.APIClient
+	enablementClient    *serviceenablement.APIClient
+	extensionReferences extensionReferencesClient
+	providerData        core.ProviderData
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
-// Use the modifier to set the effective region in the current plan.
+// Use the modifier to set the effective region in the current plan and to validate the resources referenced by the extensions.
 func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
 	var configModel Model
 	// skip initial empty configuration to avoid follow-up errors
@@ -280,12 +306,248 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	var stateModel *Model
+	if !req.State.Raw.IsNull() {
+		stateModel = &Model{}
+		resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !req.Plan.Raw.IsNull() {
+		validateExtensionReferences(ctx, &resp.Diagnostics, &planModel, stateModel, r.extensionReferences)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !req.Plan.Raw.IsNull() && !utils.IsUndefined(planModel.Region) {
+		region := planModel.Region.ValueString()
+		providerOptions, err := providerOptionsCache.Get(region, func() (*ske.ProviderOptions, error) {
+			return r.skeClient.ListProviderOptions(ctx, region).Execute()
+		})
+		if err != nil {
+			utils.LogCatalogError(ctx, "SKE provider options", err)
+		} else {
+			validateProviderOptions(ctx, &resp.Diagnostics, &configModel, stateModel, providerOptions)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			predictVersionsUsed(ctx, &resp.Diagnostics, &planModel, stateModel, providerOptions)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 }
 
+// validateProviderOptions validates the configured versions, machine types, OS images and volume types
+// against the options offered by SKE. Unknown values are skipped. Only values that differ from the state
+// (nil on create) are validated, so that existing clusters keep planning after an option has been retired.
+func validateProviderOptions(ctx context.Context, diags *diag.Diagnostics, model, state *Model, providerOptions *ske.ProviderOptions) {
+	stateModel := &Model{}
+	if state != nil {
+		stateModel = state
+	}
+	if !utils.IsUndefined(model.KubernetesVersionMin) && !model.KubernetesVersionMin.Equal(stateModel.KubernetesVersionMin) {
+		versions := []string{}
+		for _, v := range providerOptions.GetKubernetesVersions() {
+			versions = append(versions, v.GetVersion())
+		}
+		validateVersionPrefix(ctx, diags, "kubernetes_version_min", model.KubernetesVersionMin.ValueString(), versions)
+	}
+
+	if utils.IsUndefined(model.NodePools) {
+		return
+	}
+	nodePools := []nodePool{}
+	diags.Append(model.NodePools.ElementsAs(ctx, &nodePools, false)...)
+	if diags.HasError() {
+		return
+	}
+	stateNodePools := []nodePool{}
+	if !utils.IsUndefined(stateModel.NodePools) {
+		diags.Append(stateModel.NodePools.ElementsAs(ctx, &stateNodePools, false)...)
+		if diags.HasError() {
+			return
+		}
+	}
+	validateNodePoolProviderOptions(ctx, diags, nodePools, stateNodePools, providerOptions)
+}
+
+// validateNodePoolProviderOptions validates the machine types, OS images and volume types of the node pools
+// against the options offered by SKE. Unknown values are skipped. Only values that differ from the state node pool
+// with the same name are validated.
+func validateNodePoolProviderOptions(ctx context.Context, diags *diag.Diagnostics, nodePools, stateNodePools []nodePool, providerOptions *ske.ProviderOptions) {
+	machineTypes := []string{}
+	for _, machineType := range providerOptions.GetMachineTypes() {
+		machineTypes = append(machineTypes, machineType.GetName())
+	}
+	volumeTypes := []string{}
+	for _, volumeType := range providerOptions.GetVolumeTypes() {
+		volumeTypes = append(volumeTypes, volumeType.GetName())
+	}
+	imageNames := []string{}
+	imageVersions := map[string][]string{}
+	for _, image := range providerOptions.GetMachineImages() {
+		imageNames = append(imageNames, image.GetName())
+		for _, v := range image.GetVersions() {
+			imageVersions[image.GetName()] = append(imageVersions[image.GetName()], v.GetVersion())
+		}
+	}
+
+	for i := range nodePools {
+		np := &nodePools[i]
+		stateNp := &nodePool{}
+		for j := range stateNodePools {
+			if stateNodePools[j].Name.Equal(np.Name) {
+				stateNp = &stateNodePools[j]
+				break
+			}
+		}
+
+		if !utils.IsUndefined(np.MachineType) && !np.MachineType.Equal(stateNp.MachineType) {
+			utils.ValidateCatalogValue(ctx, diags, "machine_type", np.MachineType.ValueString(), machineTypes)
+		}
+		if !utils.IsUndefined(np.VolumeType) && !np.VolumeType.Equal(stateNp.VolumeType) {
+			utils.ValidateCatalogValue(ctx, diags, "volume_type", np.VolumeType.ValueString(), volumeTypes)
+		}
+		if utils.IsUndefined(np.OSName) {
+			continue
+		}
+		// The versions are offered per image, so they are validated again if the image changed
+		osNameChanged := !np.OSName.Equal(stateNp.OSName)
+		osVersionMinChanged := osNameChanged || !np.OSVersionMin.Equal(stateNp.OSVersionMin)
+		osVersionChanged := osNameChanged || !np.OSVersion.Equal(stateNp.OSVersion)
+		if osNameChanged && !utils.ValidateCatalogValue(ctx, diags, "os_name", np.OSName.ValueString(), imageNames) {
+			continue
+		}
+		// Use the casing of the API
+		versions := []string{}
+		found := false
+		for name, v := range imageVersions {
+			if strings.EqualFold(name, np.OSName.ValueString()) {
+				versions = v
+				found = true
+			}
+		}
+		// The versions of a retired image are unknown
+		if !found {
+			continue
+		}
+		if !utils.IsUndefined(np.OSVersionMin) && osVersionMinChanged {
+			validateVersionPrefix(ctx, diags, "os_version_min", np.OSVersionMin.ValueString(), versions)
+		}
+		if !utils.IsUndefined(np.OSVersion) && osVersionChanged {
+			validateVersionPrefix(ctx, diags, "os_version", np.OSVersion.ValueString(), versions)
+		}
+	}
+}
+
+// predictVersionsUsed sets the Kubernetes and OS image versions that will be selected on apply in the plan,
+// using the same selection as the create/update payload. Only unknown values are set, so unchanged clusters keep
+// their state. Values that can't be determined (e.g. invalid versions) are left unknown and fail on apply.
+func predictVersionsUsed(ctx context.Context, diags *diag.Diagnostics, plan, state *Model, providerOptions *ske.ProviderOptions) {
+	if plan.KubernetesVersionUsed.IsUnknown() && !plan.KubernetesVersionMin.IsUnknown() {
+		var currentVersion *string
+		if state != nil {
+			currentVersion = conversion.StringValueToPointer(state.KubernetesVersionUsed)
+		}
+		// the versions are sorted during the selection, copy them to not modify the cached provider options
+		availableVersions := append([]ske.KubernetesVersion{}, providerOptions.GetKubernetesVersions()...)
+		version, deprecated, err := latestMatchingKubernetesVersion(availableVersions, conversion.StringValueToPointer(plan.KubernetesVersionMin), currentVersion, diags)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Predicting Kubernetes version: %v", err))
+		} else {
+			plan.KubernetesVersionUsed = types.StringPointerValue(version)
+			if deprecated {
+				diags.AddWarning("Deprecated Kubernetes version", fmt.Sprintf("Version %s of Kubernetes is deprecated, please update it", *version))
+			}
+		}
+	}
+
+	if utils.IsUndefined(plan.NodePools) {
+		return
+	}
+	nodePools := []nodePool{}
+	diags.Append(plan.NodePools.ElementsAs(ctx, &nodePools, false)...)
+	if diags.HasError() {
+		return
+	}
+	currentImages := map[string]*ske.Image{}
+	if state != nil && !utils.IsUndefined(state.NodePools) {
+		stateNodePools := []nodePool{}
+		diags.Append(state.NodePools.ElementsAs(ctx, &stateNodePools, false)...)
+		if diags.HasError() {
+			return
+		}
+		for i := range stateNodePools {
+			np := &stateNodePools[i]
+			if utils.IsUndefined(np.OSName) || utils.IsUndefined(np.OSVersionUsed) {
+				continue
+			}
+			currentImages[np.Name.ValueString()] = &ske.Image{
+				Name:    np.OSName.ValueStringPointer(),
+				Version: np.OSVersionUsed.ValueStringPointer(),
+			}
+		}
+	}
+
+	deprecatedVersionsUsed := []string{}
+	for i := range nodePools {
+		np := &nodePools[i]
+		if !np.OSVersionUsed.IsUnknown() || utils.IsUndefined(np.OSName) || np.OSVersionMin.IsUnknown() || np.OSVersion.IsUnknown() {
+			continue
+		}
+		versionMin := conversion.StringValueToPointer(np.OSVersionMin)
+		if !np.OSVersion.IsNull() {
+			if versionMin != nil {
+				continue
+			}
+			versionMin = np.OSVersion.ValueStringPointer()
+		}
+		version, deprecated, err := latestMatchingMachineVersion(providerOptions.GetMachineImages(), versionMin, np.OSName.ValueString(), currentImages[np.Name.ValueString()])
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Predicting OS image version of node pool %q: %v", np.Name.ValueString(), err))
+			continue
+		}
+		np.OSVersionUsed = types.StringPointerValue(version)
+		if deprecated {
+			deprecatedVersionsUsed = append(deprecatedVersionsUsed, *version)
+		}
+	}
+	if len(deprecatedVersionsUsed) != 0 {
+		diags.AddWarning("Deprecated node pools OS versions used", fmt.Sprintf("The following versions of machines are deprecated, please update them: [%s]", strings.Join(deprecatedVersionsUsed, ",")))
+	}
+
+	nodePoolsTF, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: nodePoolTypes}, nodePools)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	plan.NodePools = nodePoolsTF
+}
+
+// validateVersionPrefix validates that version is one of the available versions or a prefix of one,
+// e.g. "1.31" matches "1.31.4"
+func validateVersionPrefix(ctx context.Context, diags *diag.Diagnostics, attribute, version string, available []string) {
+	version = strings.TrimPrefix(version, "v")
+	for _, v := range available {
+		v = strings.TrimPrefix(v, "v")
+		if v == version || strings.HasPrefix(v, version+".") {
+			return
+		}
+	}
+	core.LogAndAddError(ctx, diags, fmt.Sprintf("Invalid %s", attribute), utils.CatalogValueErrorDetail(attribute, version, available))
+}
+
 // Metadata returns the resource type name.
 func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_ske_cluster"
@@ -307,8 +569,20 @@ func (r *clusterResource) Configure(ctx context.Context, req resource.ConfigureR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	dnsClient := dnsUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	observabilityClient := observabilityUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	r.skeClient = skeClient
 	r.enablementClient = serviceEnablementClient
+	r.extensionReferences = &extensionReferencesAPIClient{
+		dnsClient:           dnsClient,
+		observabilityClient: observabilityClient,
+	}
 	tflog.Info(ctx, "SKE cluster clients configured")
 }
 
@@ -322,6 +596,15 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 		"max_unavailable":     "Maximum number of VMs that that can be unavailable during an update.",
 		"nodepool_validators": "If set (larger than 0), then it must be at least the amount of zones configured for the nodepool. The `max_surge` and `max_unavailable` fields cannot both be unset at the same time.",
 		"region":              "The resource region. If not defined, the provider region is used.",
+		"status":              "The aggregated status of the cluster, e.g. `STATE_HEALTHY` or `STATE_CREATING`.",
+		"errors":              "Errors reported by SKE for the cluster, e.g. a DNS zone of the DNS extension that can't be found.",
+		"errors.code":         "The error code, e.g. `SKE_DNS_ZONE_NOT_FOUND`.",
+		"errors.message":      "The error message.",
+		"hibernated":          "Whether the cluster is hibernated.",
+		"creation_time":       "Date-time when the cluster was created.",
+		"ignore_external_node_pools": "If set to `true`, node pools that are not listed in `node_pools` are kept when updating the cluster and are not shown in `node_pools`. " +
+			"Enable it to manage some node pools with `stackit_ske_node_pool` resources. Node pools removed from `node_pools` are still deleted. Defaults to `false`.",
+		"wait_for_ready": "If set to `false`, the cluster creation is not awaited: the resource is stored right after the creation request and `status` reports its readiness on later refreshes. Use the `stackit_ske_cluster` data source with `wait_for_ready = true` to wait for the cluster in dependent resources. Defaults to `true`.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -365,7 +648,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"kubernetes_version_used": schema.StringAttribute{
-				Description: "Full Kubernetes version used. For example, if 1.22 was set in `kubernetes_version_min`, this value may result to 1.22.15. " + SKEUpdateDoc,
+				Description: "Full Kubernetes version used. For example, if 1.22 was set in `kubernetes_version_min`, this value may result to 1.22.15. The version that will be selected is already shown in the plan. " + SKEUpdateDoc,
 				Computed:    true,
 			},
 			"egress_address_ranges": schema.ListAttribute{
@@ -445,7 +728,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							Optional:           true,
 						},
 						"os_version_used": schema.StringAttribute{
-							Description: "Full OS image version used. For example, if 3815.2 was set in `os_version_min`, this value may result to 3815.2.2. " + SKEUpdateDoc,
+							Description: "Full OS image version used. For example, if 3815.2 was set in `os_version_min`, this value may result to 3815.2.2. The version that will be selected is already shown in the plan. " + SKEUpdateDoc,
 							Computed:    true,
 						},
 						"volume_type": schema.StringAttribute{
@@ -475,7 +758,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							NestedObject: schema.NestedAttributeObject{
 								Attributes: map[string]schema.Attribute{
 									"effect": schema.StringAttribute{
-										Description: "The taint effect. E.g `PreferNoSchedule`.",
+										Description: fmt.Sprintf("The taint effect. %s", utils.FormatPossibleValues(taintEffects...)),
 										Required:    true,
 									},
 									"key": schema.StringAttribute{
@@ -618,7 +901,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 								Required:    true,
 							},
 							"instance_id": schema.StringAttribute{
-								Description: "Observability instance ID to choose which Observability instance is used. Required when enabled is set to `true`.",
+								Description: "Observability instance ID to choose which Observability instance is used. Required when enabled is set to `true`. When the instance is changed, the plan fails if it doesn't exist or isn't ready.",
 								Optional:    true,
 							},
 						},
@@ -647,7 +930,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 								Required:    true,
 							},
 							"zones": schema.ListAttribute{
-								Description: "Specify a list of domain filters for externalDNS (e.g., `foo.runs.onstackit.cloud`)",
+								Description: "Specify a list of domain filters for externalDNS (e.g., `foo.runs.onstackit.cloud`). When the zones are changed, the plan fails if a zone doesn't exist in the project.",
 								Optional:    true,
 								Computed:    true,
 								ElementType: types.StringType,
@@ -668,10 +951,51 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+			"errors": schema.ListNestedAttribute{
+				Description: descriptions["errors"],
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"code": schema.StringAttribute{
+							Description: descriptions["errors.code"],
+							Computed:    true,
+						},
+						"message": schema.StringAttribute{
+							Description: descriptions["errors.message"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"hibernated": schema.BoolAttribute{
+				Description: descriptions["hibernated"],
+				Computed:    true,
+			},
+			"creation_time": schema.StringAttribute{
+				Description: descriptions["creation_time"],
+				Computed:    true,
+			},
+			"wait_for_ready": schema.BoolAttribute{
+				Description: descriptions["wait_for_ready"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"ignore_external_node_pools": schema.BoolAttribute{
+				Description: descriptions["ignore_external_node_pools"],
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
 		},
 	}
 }
 
+// ValidateConfig validates the node pools and the extensions of the cluster.
 // The argus extension is deprecated but can still be used until it is removed on 06 January 2026.
 func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var resourceModel Model
@@ -685,6 +1009,8 @@ func (r *clusterResource) ValidateConfig(ctx context.Context, req resource.Valid
 }
 
 func validateConfig(ctx context.Context, respDiags *diag.Diagnostics, model *Model) {
+	validateNodePools(ctx, respDiags, model)
+
 	// If no extensions are configured, return without error.
 	if utils.IsUndefined(model.Extensions) {
 		return
@@ -725,19 +1051,33 @@ func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	_, err = enablementWait.EnableServiceWaitHandler(ctx, r.enablementClient, region, projectId, utils.SKEServiceId).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "service enablement")
+	_, err = enablementWait.EnableServiceWaitHandler(waitCtx, r.enablementClient, region, projectId, utils.SKEServiceId).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Wait for SKE enablement: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Wait for SKE enablement: %v", err)))
 		return
 	}
 
-	availableKubernetesVersions, availableMachines, err := r.loadAvailableVersions(ctx, region)
+	availableKubernetesVersions, availableMachines, err := loadAvailableVersions(ctx, r.skeClient, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Loading available Kubernetes and machine image versions: %v", err))
 		return
 	}
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, nil, nil)
+	unlock := skeUtils.LockCluster(projectId, region, clusterName)
+	defer unlock()
+
+	var externalNodePools []ske.Nodepool
+	if model.IgnoreExternalNodePools.ValueBool() {
+		externalNodePools, err = getExternalNodePools(ctx, r.skeClient, &model, nil)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating cluster", fmt.Sprintf("Loading external node pools: %v", err))
+			return
+		}
+	}
+
+	waitForReady := utils.IsUndefined(model.WaitForReady) || model.WaitForReady.ValueBool()
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, nil, nil, externalNodePools, waitForReady)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -778,8 +1118,7 @@ func sortK8sVersions(versions []ske.KubernetesVersion) {
 // loadAvailableVersions loads the available k8s and machine versions from the API.
 // The k8s versions are sorted  descending order, i.e. the latest versions (including previews)
 // are listed first
-func (r *clusterResource) loadAvailableVersions(ctx context.Context, region string) ([]ske.KubernetesVersion, []ske.MachineImage, error) {
-	c := r.skeClient
+func loadAvailableVersions(ctx context.Context, c *ske.APIClient, region string) ([]ske.KubernetesVersion, []ske.MachineImage, error) {
 	res, err := c.ListProviderOptions(ctx, region).Execute()
 	if err != nil {
 		return nil, nil, fmt.Errorf("calling API: %w", err)
@@ -824,7 +1163,45 @@ func getCurrentVersions(ctx context.Context, c skeClient, m *Model) (kubernetesV
 	return kubernetesVersion, nodePoolMachineImages
 }
 
-func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Model, availableKubernetesVersions []ske.KubernetesVersion, availableMachineVersions []ske.MachineImage, currentKubernetesVersion *string, currentMachineImages map[string]*ske.Image) {
+// getExternalNodePools returns the node pools of the cluster that are not managed by the cluster resource, i.e. that
+// are neither planned nor in the previous state. Returns no node pools if the cluster doesn't exist yet.
+func getExternalNodePools(ctx context.Context, c skeClient, plan, state *Model) ([]ske.Nodepool, error) {
+	cl, err := c.GetClusterExecute(ctx, plan.ProjectId.ValueString(), plan.Region.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		var oapiErr *oapierror.GenericOpenAPIError
+		if errors.As(err, &oapiErr) && oapiErr.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("calling API: %w", err)
+	}
+
+	managed := map[string]bool{}
+	for _, m := range []*Model{plan, state} {
+		if m == nil || utils.IsUndefined(m.NodePools) {
+			continue
+		}
+		nodePools := []nodePool{}
+		diags := m.NodePools.ElementsAs(ctx, &nodePools, false)
+		if diags.HasError() {
+			return nil, core.DiagsToError(diags)
+		}
+		for i := range nodePools {
+			managed[nodePools[i].Name.ValueString()] = true
+		}
+	}
+
+	external := []ske.Nodepool{}
+	for _, np := range cl.GetNodepools() {
+		if !managed[np.GetName()] {
+			external = append(external, np)
+		}
+	}
+	return external, nil
+}
+
+// createOrUpdateCluster sends the create/update request and waits for the cluster to be ready.
+// If waitForReady is false, the model is mapped from the API response right away, without waiting.
+func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag.Diagnostics, model *Model, availableKubernetesVersions []ske.KubernetesVersion, availableMachineVersions []ske.MachineImage, currentKubernetesVersion *string, currentMachineImages map[string]*ske.Image, externalNodePools []ske.Nodepool, waitForReady bool) {
 	// cluster vars
 	projectId := model.ProjectId.ValueString()
 	name := model.Name.ValueString()
@@ -842,6 +1219,11 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Creating node pools API payload: %v", err))
 		return
 	}
+	nodePools = append(nodePools, externalNodePools...)
+	if err := verifySystemComponentsInNodePools(nodePools); err != nil {
+		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Creating node pools API payload: %v", err))
+		return
+	}
 	if len(deprecatedVersionsUsed) != 0 {
 		diags.AddWarning("Deprecated node pools OS versions used", fmt.Sprintf("The following versions of machines are deprecated, please update them: [%s]", strings.Join(deprecatedVersionsUsed, ",")))
 	}
@@ -874,15 +1256,25 @@ func (r *clusterResource) createOrUpdateCluster(ctx context.Context, diags *diag
 		Network:     network,
 		Nodepools:   &nodePools,
 	}
-	_, err = r.skeClient.CreateOrUpdateCluster(ctx, projectId, region, name).CreateOrUpdateClusterPayload(payload).Execute()
+	createResp, err := r.skeClient.CreateOrUpdateCluster(ctx, projectId, region, name).CreateOrUpdateClusterPayload(payload).Execute()
 	if err != nil {
 		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
 
-	waitResp, err := skeWait.CreateOrUpdateClusterWaitHandler(ctx, r.skeClient, projectId, region, name).WaitWithContext(ctx)
+	if !waitForReady {
+		tflog.Info(ctx, "Not waiting for SKE cluster to be ready")
+		err = mapFields(ctx, createResp, model, region)
+		if err != nil {
+			core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Processing API payload: %v", err))
+		}
+		return
+	}
+
+	waitCtx := core.InitWaitProgress(ctx, "cluster")
+	waitResp, err := clusterReadyWaitHandler(waitCtx, r.skeClient, projectId, region, name).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", fmt.Sprintf("Cluster creation waiting: %v", err))
+		core.LogAndAddError(ctx, diags, "Error creating/updating cluster", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Cluster creation waiting: %v", err)))
 		return
 	}
 	if waitResp.Status.Error != nil && waitResp.Status.Error.Message != nil && *waitResp.Status.Error.Code == ske.RUNTIMEERRORCODE_OBSERVABILITY_INSTANCE_NOT_FOUND {
@@ -906,121 +1298,133 @@ func toNodepoolsPayload(ctx context.Context, m *Model, availableMachineVersions
 	cnps := []ske.Nodepool{}
 	deprecatedVersionsUsed := []string{}
 	for i := range nodePools {
-		nodePool := nodePools[i]
+		nodePool := &nodePools[i]
 
 		name := conversion.StringValueToPointer(nodePool.Name)
 		if name == nil {
 			return nil, nil, fmt.Errorf("found nil node pool name for node_pool[%d]", i)
 		}
 
-		// taints
-		taintsModel := []taint{}
-		diags := nodePool.Taints.ElementsAs(ctx, &taintsModel, false)
-		if diags.HasError() {
-			return nil, nil, core.DiagsToError(diags)
+		cnp, deprecatedVersion, err := toNodepoolPayload(ctx, nodePool, availableMachineVersions, currentMachineImages[*name])
+		if err != nil {
+			return nil, nil, err
 		}
-
-		ts := []ske.Taint{}
-		for _, v := range taintsModel {
-			t := ske.Taint{
-				Effect: ske.TaintGetEffectAttributeType(conversion.StringValueToPointer(v.Effect)),
-				Key:    conversion.StringValueToPointer(v.Key),
-				Value:  conversion.StringValueToPointer(v.Value),
-			}
-			ts = append(ts, t)
+		if deprecatedVersion != nil {
+			deprecatedVersionsUsed = append(deprecatedVersionsUsed, *deprecatedVersion)
 		}
+		cnps = append(cnps, *cnp)
+	}
 
-		// labels
-		var ls *map[string]string
-		if nodePool.Labels.IsNull() {
-			ls = nil
-		} else {
-			lsm := map[string]string{}
-			for k, v := range nodePool.Labels.Elements() {
-				nv, err := conversion.ToString(ctx, v)
-				if err != nil {
-					lsm[k] = ""
-					continue
-				}
-				lsm[k] = nv
-			}
-			ls = &lsm
+	return cnps, deprecatedVersionsUsed, nil
+}
+
+// toNodepoolPayload creates the payload of a single node pool. If the selected OS image version is deprecated, it is returned as well.
+func toNodepoolPayload(ctx context.Context, nodePool *nodePool, availableMachineVersions []ske.MachineImage, currentMachineImage *ske.Image) (*ske.Nodepool, *string, error) {
+	name := conversion.StringValueToPointer(nodePool.Name)
+	if name == nil {
+		return nil, nil, fmt.Errorf("found nil node pool name")
+	}
+
+	// taints
+	taintsModel := []taint{}
+	diags := nodePool.Taints.ElementsAs(ctx, &taintsModel, false)
+	if diags.HasError() {
+		return nil, nil, core.DiagsToError(diags)
+	}
+
+	ts := []ske.Taint{}
+	for _, v := range taintsModel {
+		t := ske.Taint{
+			Effect: ske.TaintGetEffectAttributeType(conversion.StringValueToPointer(v.Effect)),
+			Key:    conversion.StringValueToPointer(v.Key),
+			Value:  conversion.StringValueToPointer(v.Value),
 		}
+		ts = append(ts, t)
+	}
 
-		// zones
-		zs := []string{}
-		for _, v := range nodePool.AvailabilityZones.Elements() {
-			if v.IsNull() || v.IsUnknown() {
-				continue
-			}
-			s, err := conversion.ToString(ctx, v)
+	// labels
+	var ls *map[string]string
+	if nodePool.Labels.IsNull() {
+		ls = nil
+	} else {
+		lsm := map[string]string{}
+		for k, v := range nodePool.Labels.Elements() {
+			nv, err := conversion.ToString(ctx, v)
 			if err != nil {
+				lsm[k] = ""
 				continue
 			}
-			zs = append(zs, s)
-		}
-
-		cn := ske.CRI{
-			Name: ske.CRIGetNameAttributeType(conversion.StringValueToPointer(nodePool.CRI)),
+			lsm[k] = nv
 		}
+		ls = &lsm
+	}
 
-		providedVersionMin := conversion.StringValueToPointer(nodePool.OSVersionMin)
-		if !nodePool.OSVersion.IsNull() {
-			if providedVersionMin != nil {
-				return nil, nil, fmt.Errorf("both `os_version` and `os_version_min` are set for for node_pool %q. Please use `os_version_min` only, `os_version` is deprecated", *name)
-			}
-			// os_version field deprecation
-			// this if clause should be removed once os_version field is completely removed
-			// os_version field value is used as minimum os version
-			providedVersionMin = conversion.StringValueToPointer(nodePool.OSVersion)
+	// zones
+	zs := []string{}
+	for _, v := range nodePool.AvailabilityZones.Elements() {
+		if v.IsNull() || v.IsUnknown() {
+			continue
 		}
-
-		machineOSName := conversion.StringValueToPointer(nodePool.OSName)
-		if machineOSName == nil {
-			return nil, nil, fmt.Errorf("found nil machine name for node_pool %q", *name)
+		s, err := conversion.ToString(ctx, v)
+		if err != nil {
+			continue
 		}
+		zs = append(zs, s)
+	}
 
-		currentMachineImage := currentMachineImages[*name]
+	cn := ske.CRI{
+		Name: ske.CRIGetNameAttributeType(conversion.StringValueToPointer(nodePool.CRI)),
+	}
 
-		machineVersion, hasDeprecatedVersion, err := latestMatchingMachineVersion(availableMachineVersions, providedVersionMin, *machineOSName, currentMachineImage)
-		if err != nil {
-			return nil, nil, fmt.Errorf("getting latest matching machine image version: %w", err)
-		}
-		if hasDeprecatedVersion && machineVersion != nil {
-			deprecatedVersionsUsed = append(deprecatedVersionsUsed, *machineVersion)
-		}
-
-		cnp := ske.Nodepool{
-			Name:           name,
-			Minimum:        conversion.Int64ValueToPointer(nodePool.Minimum),
-			Maximum:        conversion.Int64ValueToPointer(nodePool.Maximum),
-			MaxSurge:       conversion.Int64ValueToPointer(nodePool.MaxSurge),
-			MaxUnavailable: conversion.Int64ValueToPointer(nodePool.MaxUnavailable),
-			Machine: &ske.Machine{
-				Type: conversion.StringValueToPointer(nodePool.MachineType),
-				Image: &ske.Image{
-					Name:    machineOSName,
-					Version: machineVersion,
-				},
-			},
-			Volume: &ske.Volume{
-				Type: conversion.StringValueToPointer(nodePool.VolumeType),
-				Size: conversion.Int64ValueToPointer(nodePool.VolumeSize),
-			},
-			Taints:                &ts,
-			Cri:                   &cn,
-			Labels:                ls,
-			AvailabilityZones:     &zs,
-			AllowSystemComponents: conversion.BoolValueToPointer(nodePool.AllowSystemComponents),
+	providedVersionMin := conversion.StringValueToPointer(nodePool.OSVersionMin)
+	if !nodePool.OSVersion.IsNull() {
+		if providedVersionMin != nil {
+			return nil, nil, fmt.Errorf("both `os_version` and `os_version_min` are set for for node_pool %q. Please use `os_version_min` only, `os_version` is deprecated", *name)
 		}
-		cnps = append(cnps, cnp)
+		// os_version field deprecation
+		// this if clause should be removed once os_version field is completely removed
+		// os_version field value is used as minimum os version
+		providedVersionMin = conversion.StringValueToPointer(nodePool.OSVersion)
 	}
 
-	if err := verifySystemComponentsInNodePools(cnps); err != nil {
-		return nil, nil, err
+	machineOSName := conversion.StringValueToPointer(nodePool.OSName)
+	if machineOSName == nil {
+		return nil, nil, fmt.Errorf("found nil machine name for node_pool %q", *name)
 	}
 
-	return cnps, deprecatedVersionsUsed, nil
+	machineVersion, hasDeprecatedVersion, err := latestMatchingMachineVersion(availableMachineVersions, providedVersionMin, *machineOSName, currentMachineImage)
+	if err != nil {
+		return nil, nil, fmt.Errorf("getting latest matching machine image version: %w", err)
+	}
+	var deprecatedVersion *string
+	if hasDeprecatedVersion && machineVersion != nil {
+		deprecatedVersion = machineVersion
+	}
+
+	cnp := ske.Nodepool{
+		Name:           name,
+		Minimum:        conversion.Int64ValueToPointer(nodePool.Minimum),
+		Maximum:        conversion.Int64ValueToPointer(nodePool.Maximum),
+		MaxSurge:       conversion.Int64ValueToPointer(nodePool.MaxSurge),
+		MaxUnavailable: conversion.Int64ValueToPointer(nodePool.MaxUnavailable),
+		Machine: &ske.Machine{
+			Type: conversion.StringValueToPointer(nodePool.MachineType),
+			Image: &ske.Image{
+				Name:    machineOSName,
+				Version: machineVersion,
+			},
+		},
+		Volume: &ske.Volume{
+			Type: conversion.StringValueToPointer(nodePool.VolumeType),
+			Size: conversion.Int64ValueToPointer(nodePool.VolumeSize),
+		},
+		Taints:                &ts,
+		Cri:                   &cn,
+		Labels:                ls,
+		AvailabilityZones:     &zs,
+		AllowSystemComponents: conversion.BoolValueToPointer(nodePool.AllowSystemComponents),
+	}
+	return &cnp, deprecatedVersion, nil
 }
 
 // verifySystemComponentsInNodePools checks if at least one node pool has the allow_system_components attribute set to true.
@@ -1365,6 +1769,11 @@ func mapFields(ctx context.Context, cl *ske.Cluster, m *Model, region string) er
 	m.Id = utils.BuildInternalTerraformId(m.ProjectId.ValueString(), region, name)
 	m.Region = types.StringValue(region)
 
+	err := mapStatus(cl, m)
+	if err != nil {
+		return fmt.Errorf("map status: %w", err)
+	}
+
 	if cl.Kubernetes != nil {
 		m.KubernetesVersionUsed = types.StringPointerValue(cl.Kubernetes.Version)
 	}
@@ -1387,7 +1796,7 @@ func mapFields(ctx context.Context, cl *ske.Cluster, m *Model, region string) er
 		}
 	}
 
-	err := mapNodePools(ctx, cl, m)
+	err = mapNodePools(ctx, cl, m)
 	if err != nil {
 		return fmt.Errorf("map node_pools: %w", err)
 	}
@@ -1410,9 +1819,45 @@ func mapFields(ctx context.Context, cl *ske.Cluster, m *Model, region string) er
 	return nil
 }
 
+func mapStatus(cl *ske.Cluster, m *Model) error {
+	m.Status = types.StringNull()
+	m.Hibernated = types.BoolNull()
+	m.CreationTime = types.StringNull()
+	m.Errors = types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes})
+	if cl.Status == nil {
+		return nil
+	}
+	if cl.Status.Aggregated != nil {
+		m.Status = types.StringValue(string(*cl.Status.Aggregated))
+	}
+	m.Hibernated = types.BoolPointerValue(cl.Status.Hibernated)
+	if cl.Status.CreationTime != nil {
+		m.CreationTime = types.StringValue(cl.Status.CreationTime.Format(time.RFC3339))
+	}
+
+	errorsTF := []attr.Value{}
+	for _, e := range getClusterErrors(cl) {
+		errorTF, diags := types.ObjectValue(clusterErrorTypes, map[string]attr.Value{
+			"code":    types.StringValue(e.code),
+			"message": types.StringValue(e.message),
+		})
+		if diags.HasError() {
+			return fmt.Errorf("map errors: %w", core.DiagsToError(diags))
+		}
+		errorsTF = append(errorsTF, errorTF)
+	}
+	errorsList, diags := types.ListValue(types.ObjectType{AttrTypes: clusterErrorTypes}, errorsTF)
+	if diags.HasError() {
+		return fmt.Errorf("map errors: %w", core.DiagsToError(diags))
+	}
+	m.Errors = errorsList
+	return nil
+}
+
 func mapNodePools(ctx context.Context, cl *ske.Cluster, model *Model) error {
 	modelNodePoolOSVersion := map[string]basetypes.StringValue{}
 	modelNodePoolOSVersionMin := map[string]basetypes.StringValue{}
+	modelNodePoolTaints := map[string]bool{}
 
 	modelNodePools := []nodePool{}
 	if !model.NodePools.IsNull() && !model.NodePools.IsUnknown() {
@@ -1427,8 +1872,11 @@ func mapNodePools(ctx context.Context, cl *ske.Cluster, model *Model) error {
 		if name != nil {
 			modelNodePoolOSVersion[*name] = modelNodePools[i].OSVersion
 			modelNodePoolOSVersionMin[*name] = modelNodePools[i].OSVersionMin
+			modelNodePoolTaints[*name] = !modelNodePools[i].Taints.IsNull() && !modelNodePools[i].Taints.IsUnknown()
 		}
 	}
+	// Node pools managed outside of the resource are left out, unless there are no node pools in the model, e.g. after an import
+	ignoreExternal := model.IgnoreExternalNodePools.ValueBool() && len(modelNodePools) > 0
 
 	if cl.Nodepools == nil {
 		model.NodePools = types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes})
@@ -1436,79 +1884,89 @@ func mapNodePools(ctx context.Context, cl *ske.Cluster, model *Model) error {
 	}
 
 	nodePools := []attr.Value{}
-	for i, nodePoolResp := range *cl.Nodepools {
-		nodePool := map[string]attr.Value{
-			"name":                    types.StringPointerValue(nodePoolResp.Name),
-			"machine_type":            types.StringPointerValue(nodePoolResp.Machine.Type),
-			"os_name":                 types.StringNull(),
-			"os_version_min":          modelNodePoolOSVersionMin[*nodePoolResp.Name],
-			"os_version":              modelNodePoolOSVersion[*nodePoolResp.Name],
-			"minimum":                 types.Int64PointerValue(nodePoolResp.Minimum),
-			"maximum":                 types.Int64PointerValue(nodePoolResp.Maximum),
-			"max_surge":               types.Int64PointerValue(nodePoolResp.MaxSurge),
-			"max_unavailable":         types.Int64PointerValue(nodePoolResp.MaxUnavailable),
-			"volume_type":             types.StringNull(),
-			"volume_size":             types.Int64PointerValue(nodePoolResp.Volume.Size),
-			"labels":                  types.MapNull(types.StringType),
-			"cri":                     types.StringNull(),
-			"availability_zones":      types.ListNull(types.StringType),
-			"allow_system_components": types.BoolPointerValue(nodePoolResp.AllowSystemComponents),
+	for i := range *cl.Nodepools {
+		nodePoolResp := &(*cl.Nodepools)[i]
+		name := nodePoolResp.GetName()
+		if _, ok := modelNodePoolOSVersion[name]; ignoreExternal && !ok {
+			continue
 		}
-
-		if nodePoolResp.Machine != nil && nodePoolResp.Machine.Image != nil {
-			nodePool["os_name"] = types.StringPointerValue(nodePoolResp.Machine.Image.Name)
-			nodePool["os_version_used"] = types.StringPointerValue(nodePoolResp.Machine.Image.Version)
+		nodePoolTF, err := mapNodePool(ctx, nodePoolResp, modelNodePoolOSVersion[name], modelNodePoolOSVersionMin[name], modelNodePoolTaints[name])
+		if err != nil {
+			return fmt.Errorf("mapping index %d: %w", i, err)
 		}
+		nodePools = append(nodePools, nodePoolTF)
+	}
+	nodePoolsTF, diags := basetypes.NewListValue(types.ObjectType{AttrTypes: nodePoolTypes}, nodePools)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+	model.NodePools = nodePoolsTF
+	return nil
+}
 
-		if nodePoolResp.Volume != nil {
-			nodePool["volume_type"] = types.StringPointerValue(nodePoolResp.Volume.Type)
-		}
+// mapNodePool maps a node pool of the API response. The OS versions are not returned by the API, they are taken from the model.
+func mapNodePool(ctx context.Context, nodePoolResp *ske.Nodepool, osVersion, osVersionMin types.String, taintsInModel bool) (types.Object, error) {
+	nodePool := map[string]attr.Value{
+		"name":                    types.StringPointerValue(nodePoolResp.Name),
+		"machine_type":            types.StringPointerValue(nodePoolResp.Machine.Type),
+		"os_name":                 types.StringNull(),
+		"os_version_min":          osVersionMin,
+		"os_version":              osVersion,
+		"minimum":                 types.Int64PointerValue(nodePoolResp.Minimum),
+		"maximum":                 types.Int64PointerValue(nodePoolResp.Maximum),
+		"max_surge":               types.Int64PointerValue(nodePoolResp.MaxSurge),
+		"max_unavailable":         types.Int64PointerValue(nodePoolResp.MaxUnavailable),
+		"volume_type":             types.StringNull(),
+		"volume_size":             types.Int64PointerValue(nodePoolResp.Volume.Size),
+		"labels":                  types.MapNull(types.StringType),
+		"cri":                     types.StringNull(),
+		"availability_zones":      types.ListNull(types.StringType),
+		"allow_system_components": types.BoolPointerValue(nodePoolResp.AllowSystemComponents),
+	}
 
-		if nodePoolResp.Cri != nil {
-			nodePool["cri"] = types.StringValue(string(nodePoolResp.Cri.GetName()))
-		}
+	if nodePoolResp.Machine != nil && nodePoolResp.Machine.Image != nil {
+		nodePool["os_name"] = types.StringPointerValue(nodePoolResp.Machine.Image.Name)
+		nodePool["os_version_used"] = types.StringPointerValue(nodePoolResp.Machine.Image.Version)
+	}
 
-		taintsInModel := false
-		if i < len(modelNodePools) && !modelNodePools[i].Taints.IsNull() && !modelNodePools[i].Taints.IsUnknown() {
-			taintsInModel = true
-		}
-		err := mapTaints(nodePoolResp.Taints, nodePool, taintsInModel)
-		if err != nil {
-			return fmt.Errorf("mapping index %d, field taints: %w", i, err)
-		}
+	if nodePoolResp.Volume != nil {
+		nodePool["volume_type"] = types.StringPointerValue(nodePoolResp.Volume.Type)
+	}
 
-		if nodePoolResp.Labels != nil {
-			elems := map[string]attr.Value{}
-			for k, v := range *nodePoolResp.Labels {
-				elems[k] = types.StringValue(v)
-			}
-			elemsTF, diags := types.MapValue(types.StringType, elems)
-			if diags.HasError() {
-				return fmt.Errorf("mapping index %d, field labels: %w", i, core.DiagsToError(diags))
-			}
-			nodePool["labels"] = elemsTF
-		}
+	if nodePoolResp.Cri != nil {
+		nodePool["cri"] = types.StringValue(string(nodePoolResp.Cri.GetName()))
+	}
 
-		if nodePoolResp.AvailabilityZones != nil {
-			elemsTF, diags := types.ListValueFrom(ctx, types.StringType, *nodePoolResp.AvailabilityZones)
-			if diags.HasError() {
-				return fmt.Errorf("mapping index %d, field availability_zones: %w", i, core.DiagsToError(diags))
-			}
-			nodePool["availability_zones"] = elemsTF
+	err := mapTaints(nodePoolResp.Taints, nodePool, taintsInModel)
+	if err != nil {
+		return types.Object{}, fmt.Errorf("field taints: %w", err)
+	}
+
+	if nodePoolResp.Labels != nil {
+		elems := map[string]attr.Value{}
+		for k, v := range *nodePoolResp.Labels {
+			elems[k] = types.StringValue(v)
+		}
+		elemsTF, diags := types.MapValue(types.StringType, elems)
+		if diags.HasError() {
+			return types.Object{}, fmt.Errorf("field labels: %w", core.DiagsToError(diags))
 		}
+		nodePool["labels"] = elemsTF
+	}
 
-		nodePoolTF, diags := basetypes.NewObjectValue(nodePoolTypes, nodePool)
+	if nodePoolResp.AvailabilityZones != nil {
+		elemsTF, diags := types.ListValueFrom(ctx, types.StringType, *nodePoolResp.AvailabilityZones)
 		if diags.HasError() {
-			return fmt.Errorf("mapping index %d: %w", i, core.DiagsToError(diags))
+			return types.Object{}, fmt.Errorf("field availability_zones: %w", core.DiagsToError(diags))
 		}
-		nodePools = append(nodePools, nodePoolTF)
+		nodePool["availability_zones"] = elemsTF
 	}
-	nodePoolsTF, diags := basetypes.NewListValue(types.ObjectType{AttrTypes: nodePoolTypes}, nodePools)
+
+	nodePoolTF, diags := basetypes.NewObjectValue(nodePoolTypes, nodePool)
 	if diags.HasError() {
-		return core.DiagsToError(diags)
+		return types.Object{}, core.DiagsToError(diags)
 	}
-	model.NodePools = nodePoolsTF
-	return nil
+	return nodePoolTF, nil
 }
 
 func mapTaints(t *[]ske.Taint, nodePool map[string]attr.Value, existInModel bool) error {
@@ -2117,6 +2575,10 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster", fmt.Sprintf("Processing API payload: %v", err))
 		return
 	}
+	// wait_for_ready is not part of the API, it is unset after an import
+	if state.WaitForReady.IsNull() {
+		state.WaitForReady = types.BoolValue(true)
+	}
 
 	diags = resp.State.Set(ctx, state)
 	resp.Diagnostics.Append(diags...)
@@ -2141,15 +2603,32 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	ctx = tflog.SetField(ctx, "name", clName)
 	ctx = tflog.SetField(ctx, "region", region)
 
-	availableKubernetesVersions, availableMachines, err := r.loadAvailableVersions(ctx, region)
+	availableKubernetesVersions, availableMachines, err := loadAvailableVersions(ctx, r.skeClient, region)
 	if err != nil {
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster", fmt.Sprintf("Loading available Kubernetes and machine image versions: %v", err))
 		return
 	}
 
+	unlock := skeUtils.LockCluster(projectId, region, clName)
+	defer unlock()
+
 	currentKubernetesVersion, currentMachineImages := getCurrentVersions(ctx, r.skeClient, &model)
 
-	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages)
+	var externalNodePools []ske.Nodepool
+	if model.IgnoreExternalNodePools.ValueBool() {
+		var stateModel Model
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		externalNodePools, err = getExternalNodePools(ctx, r.skeClient, &model, &stateModel)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster", fmt.Sprintf("Loading external node pools: %v", err))
+			return
+		}
+	}
+
+	r.createOrUpdateCluster(ctx, &resp.Diagnostics, &model, availableKubernetesVersions, availableMachines, currentKubernetesVersion, currentMachineImages, externalNodePools, true)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2181,9 +2660,10 @@ func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = skeWait.DeleteClusterWaitHandler(ctx, r.skeClient, projectId, region, name).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "cluster")
+	_, err = skeWait.DeleteClusterWaitHandler(waitCtx, r.skeClient, projectId, region, name).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", fmt.Sprintf("Cluster deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting cluster", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Cluster deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "SKE cluster deleted")
@@ -64,6 +64,7 @@ func TestMapFields(t *testing.T) {
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
 				Region:              types.StringValue(testRegion),
+				Errors:              types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes}),
 			},
 			true,
 		},
@@ -146,9 +147,23 @@ func TestMapFields(t *testing.T) {
 					},
 				},
 				Status: &ske.ClusterStatus{
-					Aggregated:          &cs,
-					Error:               nil,
-					Hibernated:          nil,
+					Aggregated: &cs,
+					Error: &ske.RuntimeError{
+						Code:    ske.RUNTIMEERRORCODE_OBSERVABILITY_INSTANCE_NOT_FOUND.Ptr(),
+						Message: utils.Ptr("observability instance not found"),
+					},
+					Errors: &[]ske.ClusterError{
+						{
+							Code:    ske.CLUSTERERRORCODE_OBSERVABILITY_INSTANCE_NOT_FOUND.Ptr(),
+							Message: utils.Ptr("observability instance not found"),
+						},
+						{
+							Code:    ske.CLUSTERERRORCODE_DNS_ZONE_NOT_FOUND.Ptr(),
+							Message: utils.Ptr("dns zone not found"),
+						},
+					},
+					Hibernated:          utils.Ptr(false),
+					CreationTime:        utils.Ptr(time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC)),
 					EgressAddressRanges: &[]string{"0.0.0.0/32", "1.1.1.1/32"},
 					PodAddressRanges:    &[]string{"0.0.0.0/32", "1.1.1.1/32"},
 				},
@@ -265,6 +280,19 @@ func TestMapFields(t *testing.T) {
 					}),
 				}),
 				Region: types.StringValue(testRegion),
+				Status: types.StringValue("OK"),
+				Errors: types.ListValueMust(types.ObjectType{AttrTypes: clusterErrorTypes}, []attr.Value{
+					types.ObjectValueMust(clusterErrorTypes, map[string]attr.Value{
+						"code":    types.StringValue("SKE_OBSERVABILITY_INSTANCE_NOT_FOUND"),
+						"message": types.StringValue("observability instance not found"),
+					}),
+					types.ObjectValueMust(clusterErrorTypes, map[string]attr.Value{
+						"code":    types.StringValue("SKE_DNS_ZONE_NOT_FOUND"),
+						"message": types.StringValue("dns zone not found"),
+					}),
+				}),
+				Hibernated:   types.BoolValue(false),
+				CreationTime: types.StringValue("2025-02-01T10:00:00Z"),
 			},
 			true,
 		},
@@ -289,6 +317,7 @@ func TestMapFields(t *testing.T) {
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
 				Region:              types.StringValue(testRegion),
+				Errors:              types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes}),
 			},
 			true,
 		},
@@ -339,6 +368,7 @@ func TestMapFields(t *testing.T) {
 					}),
 				}),
 				Region: types.StringValue(testRegion),
+				Errors: types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes}),
 			},
 			true,
 		},
@@ -390,6 +420,7 @@ func TestMapFields(t *testing.T) {
 					}),
 				}),
 				Region: types.StringValue(testRegion),
+				Errors: types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes}),
 			},
 			true,
 		},
@@ -454,6 +485,7 @@ func TestMapFields(t *testing.T) {
 					}),
 				}),
 				Region: types.StringValue(testRegion),
+				Errors: types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes}),
 			},
 			true,
 		},
@@ -477,6 +509,7 @@ func TestMapFields(t *testing.T) {
 				EgressAddressRanges: types.ListNull(types.StringType),
 				PodAddressRanges:    types.ListNull(types.StringType),
 				Region:              types.StringValue(testRegion),
+				Errors:              types.ListNull(types.ObjectType{AttrTypes: clusterErrorTypes}),
 			},
 			true,
 		},
@@ -682,6 +715,8 @@ func TestMapFields(t *testing.T) {
 					}),
 				}),
 				Region: types.StringValue(testRegion),
+				Status: types.StringValue("OK"),
+				Errors: types.ListValueMust(types.ObjectType{AttrTypes: clusterErrorTypes}, []attr.Value{}),
 			},
 			true,
 		},
@@ -2285,6 +2320,106 @@ func TestToNetworkPayload(t *testing.T) {
 	}
 }
 
+func TestGetExternalNodePools(t *testing.T) {
+	nodePoolsTF := func(names ...string) types.List {
+		elems := []attr.Value{}
+		for _, name := range names {
+			obj, err := mapNodePool(context.Background(), &ske.Nodepool{
+				Name: utils.Ptr(name),
+				Machine: &ske.Machine{
+					Type:  utils.Ptr("c1.2"),
+					Image: &ske.Image{Name: utils.Ptr("flatcar")},
+				},
+				Volume: &ske.Volume{},
+			}, types.StringNull(), types.StringNull(), false)
+			if err != nil {
+				t.Fatalf("mapping node pool: %v", err)
+			}
+			elems = append(elems, obj)
+		}
+		return types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, elems)
+	}
+	cluster := &ske.Cluster{
+		Nodepools: &[]ske.Nodepool{
+			{Name: utils.Ptr("a")},
+			{Name: utils.Ptr("b")},
+			{Name: utils.Ptr("c")},
+		},
+	}
+	tests := []struct {
+		description     string
+		mockedResp      *ske.Cluster
+		getClusterFails bool
+		plan            *Model
+		state           *Model
+		expected        []ske.Nodepool
+		isValid         bool
+	}{
+		{
+			"plan_only",
+			cluster,
+			false,
+			&Model{NodePools: nodePoolsTF("a")},
+			nil,
+			[]ske.Nodepool{
+				{Name: utils.Ptr("b")},
+				{Name: utils.Ptr("c")},
+			},
+			true,
+		},
+		{
+			"removed_from_plan_is_not_external",
+			cluster,
+			false,
+			&Model{NodePools: nodePoolsTF("a")},
+			&Model{NodePools: nodePoolsTF("a", "b")},
+			[]ske.Nodepool{
+				{Name: utils.Ptr("c")},
+			},
+			true,
+		},
+		{
+			"all_managed",
+			cluster,
+			false,
+			&Model{NodePools: nodePoolsTF("a", "b", "c")},
+			nil,
+			[]ske.Nodepool{},
+			true,
+		},
+		{
+			"get_fails",
+			nil,
+			true,
+			&Model{NodePools: nodePoolsTF("a")},
+			nil,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &skeClientMocked{
+				returnError:    tt.getClusterFails,
+				getClusterResp: tt.mockedResp,
+			}
+			output, err := getExternalNodePools(context.Background(), client, tt.plan, tt.state)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(output, tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestVerifySystemComponentNodepools(t *testing.T) {
 	tests := []struct {
 		description string
@@ -2637,3 +2772,287 @@ func TestValidateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateProviderOptions(t *testing.T) {
+	providerOptions := &ske.ProviderOptions{
+		KubernetesVersions: &[]ske.KubernetesVersion{
+			{Version: utils.Ptr("1.30.5")},
+			{Version: utils.Ptr("1.31.2")},
+		},
+		MachineTypes: &[]ske.MachineType{
+			{Name: utils.Ptr("c1.2")},
+			{Name: utils.Ptr("g1.2")},
+		},
+		VolumeTypes: &[]ske.VolumeType{
+			{Name: utils.Ptr("storage_premium_perf1")},
+		},
+		MachineImages: &[]ske.MachineImage{
+			{
+				Name: utils.Ptr("flatcar"),
+				Versions: &[]ske.MachineImageVersion{
+					{Version: utils.Ptr("3815.2.5")},
+				},
+			},
+		},
+	}
+	nodePoolObject := func(machineType, osName, osVersionMin string) basetypes.ObjectValue {
+		return types.ObjectValueMust(nodePoolTypes, map[string]attr.Value{
+			"name":                    types.StringValue("np"),
+			"machine_type":            types.StringValue(machineType),
+			"os_name":                 types.StringValue(osName),
+			"os_version_min":          types.StringValue(osVersionMin),
+			"os_version":              types.StringNull(),
+			"os_version_used":         types.StringUnknown(),
+			"minimum":                 types.Int64Value(1),
+			"maximum":                 types.Int64Value(2),
+			"max_surge":               types.Int64Null(),
+			"max_unavailable":         types.Int64Null(),
+			"volume_type":             types.StringValue("storage_premium_perf1"),
+			"volume_size":             types.Int64Null(),
+			"labels":                  types.MapNull(types.StringType),
+			"taints":                  types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+			"cri":                     types.StringNull(),
+			"availability_zones":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1")}),
+			"allow_system_components": types.BoolNull(),
+		})
+	}
+	tests := []struct {
+		description       string
+		kubernetesVersion types.String
+		nodePool          basetypes.ObjectValue
+		inState           bool
+		isValid           bool
+	}{
+		{
+			"valid",
+			types.StringValue("1.31"),
+			nodePoolObject("c1.2", "flatcar", "3815.2"),
+			false,
+			true,
+		},
+		{
+			"retired_values_in_state",
+			types.StringValue("1.29"),
+			nodePoolObject("c1.3", "ubuntu", "3815.3"),
+			true,
+			true,
+		},
+		{
+			"valid_unknown_version",
+			types.StringUnknown(),
+			nodePoolObject("g1.2", "flatcar", "3815.2.5"),
+			false,
+			true,
+		},
+		{
+			"invalid_kubernetes_version",
+			types.StringValue("1.29"),
+			nodePoolObject("c1.2", "flatcar", "3815.2"),
+			false,
+			false,
+		},
+		{
+			"invalid_machine_type",
+			types.StringValue("1.31"),
+			nodePoolObject("c1.3", "flatcar", "3815.2"),
+			false,
+			false,
+		},
+		{
+			"invalid_os_name",
+			types.StringValue("1.31"),
+			nodePoolObject("c1.2", "ubuntu", "3815.2"),
+			false,
+			false,
+		},
+		{
+			"invalid_os_version",
+			types.StringValue("1.31"),
+			nodePoolObject("c1.2", "flatcar", "3815.3"),
+			false,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				KubernetesVersionMin: tt.kubernetesVersion,
+				NodePools:            types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{tt.nodePool}),
+			}
+			var state *Model
+			if tt.inState {
+				state = model
+			}
+			diags := diag.Diagnostics{}
+			validateProviderOptions(context.Background(), &diags, model, state, providerOptions)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestPredictVersionsUsed(t *testing.T) {
+	providerOptions := &ske.ProviderOptions{
+		KubernetesVersions: &[]ske.KubernetesVersion{
+			{Version: utils.Ptr("1.30.5"), State: utils.Ptr(VersionStateDeprecated)},
+			{Version: utils.Ptr("1.31.1"), State: utils.Ptr(VersionStateSupported)},
+			{Version: utils.Ptr("1.31.2"), State: utils.Ptr(VersionStateSupported)},
+			{Version: utils.Ptr("1.32.0"), State: utils.Ptr(VersionStatePreview)},
+		},
+		MachineImages: &[]ske.MachineImage{
+			{
+				Name: utils.Ptr("flatcar"),
+				Versions: &[]ske.MachineImageVersion{
+					{Version: utils.Ptr("3815.2.1"), State: utils.Ptr(VersionStateDeprecated)},
+					{Version: utils.Ptr("3815.2.5"), State: utils.Ptr(VersionStateSupported)},
+				},
+			},
+		},
+	}
+	nodePoolObject := func(osVersionMin, osVersionUsed types.String) basetypes.ObjectValue {
+		return types.ObjectValueMust(nodePoolTypes, map[string]attr.Value{
+			"name":                    types.StringValue("np"),
+			"machine_type":            types.StringValue("c1.2"),
+			"os_name":                 types.StringValue("flatcar"),
+			"os_version_min":          osVersionMin,
+			"os_version":              types.StringNull(),
+			"os_version_used":         osVersionUsed,
+			"minimum":                 types.Int64Value(1),
+			"maximum":                 types.Int64Value(2),
+			"max_surge":               types.Int64Null(),
+			"max_unavailable":         types.Int64Null(),
+			"volume_type":             types.StringValue("storage_premium_perf1"),
+			"volume_size":             types.Int64Null(),
+			"labels":                  types.MapNull(types.StringType),
+			"taints":                  types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+			"cri":                     types.StringNull(),
+			"availability_zones":      types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1")}),
+			"allow_system_components": types.BoolNull(),
+		})
+	}
+	nodePoolsList := func(nodePool basetypes.ObjectValue) types.List {
+		return types.ListValueMust(types.ObjectType{AttrTypes: nodePoolTypes}, []attr.Value{nodePool})
+	}
+	tests := []struct {
+		description              string
+		plan                     *Model
+		state                    *Model
+		expectedKubernetesUsed   types.String
+		expectedNodePools        types.List
+		expectedWarningSummaries []string
+	}{
+		{
+			"create_latest_versions",
+			&Model{
+				KubernetesVersionMin:  types.StringNull(),
+				KubernetesVersionUsed: types.StringUnknown(),
+				NodePools:             nodePoolsList(nodePoolObject(types.StringNull(), types.StringUnknown())),
+			},
+			nil,
+			types.StringValue("1.31.2"),
+			nodePoolsList(nodePoolObject(types.StringNull(), types.StringValue("3815.2.5"))),
+			nil,
+		},
+		{
+			"create_deprecated_versions",
+			&Model{
+				KubernetesVersionMin:  types.StringValue("1.30"),
+				KubernetesVersionUsed: types.StringUnknown(),
+				NodePools:             nodePoolsList(nodePoolObject(types.StringValue("3815.2.1"), types.StringUnknown())),
+			},
+			nil,
+			types.StringValue("1.30.5"),
+			nodePoolsList(nodePoolObject(types.StringValue("3815.2.1"), types.StringValue("3815.2.1"))),
+			[]string{"Deprecated Kubernetes version", "Deprecated node pools OS versions used"},
+		},
+		{
+			"create_preview_version",
+			&Model{
+				KubernetesVersionMin:  types.StringValue("1.32"),
+				KubernetesVersionUsed: types.StringUnknown(),
+				NodePools:             types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			},
+			nil,
+			types.StringValue("1.32.0"),
+			types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			[]string{"preview version selected"},
+		},
+		{
+			"update_keeps_current_versions",
+			&Model{
+				KubernetesVersionMin:  types.StringValue("1.31"),
+				KubernetesVersionUsed: types.StringUnknown(),
+				NodePools:             nodePoolsList(nodePoolObject(types.StringValue("3815.2"), types.StringUnknown())),
+			},
+			&Model{
+				KubernetesVersionUsed: types.StringValue("1.31.1"),
+				NodePools:             nodePoolsList(nodePoolObject(types.StringValue("3815.2"), types.StringValue("3815.2.1"))),
+			},
+			types.StringValue("1.31.1"),
+			nodePoolsList(nodePoolObject(types.StringValue("3815.2"), types.StringValue("3815.2.1"))),
+			[]string{"Deprecated node pools OS versions used"},
+		},
+		{
+			"known_values_are_kept",
+			&Model{
+				KubernetesVersionMin:  types.StringValue("1.31"),
+				KubernetesVersionUsed: types.StringValue("1.31.1"),
+				NodePools:             nodePoolsList(nodePoolObject(types.StringNull(), types.StringValue("3815.2.1"))),
+			},
+			nil,
+			types.StringValue("1.31.1"),
+			nodePoolsList(nodePoolObject(types.StringNull(), types.StringValue("3815.2.1"))),
+			nil,
+		},
+		{
+			"unknown_version_min",
+			&Model{
+				KubernetesVersionMin:  types.StringUnknown(),
+				KubernetesVersionUsed: types.StringUnknown(),
+				NodePools:             nodePoolsList(nodePoolObject(types.StringUnknown(), types.StringUnknown())),
+			},
+			nil,
+			types.StringUnknown(),
+			nodePoolsList(nodePoolObject(types.StringUnknown(), types.StringUnknown())),
+			nil,
+		},
+		{
+			"invalid_version_stays_unknown",
+			&Model{
+				KubernetesVersionMin:  types.StringValue("1.29"),
+				KubernetesVersionUsed: types.StringUnknown(),
+				NodePools:             types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			},
+			nil,
+			types.StringUnknown(),
+			types.ListNull(types.ObjectType{AttrTypes: nodePoolTypes}),
+			nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			predictVersionsUsed(context.Background(), &diags, tt.plan, tt.state, providerOptions)
+			if diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			warnings := []string{}
+			for _, w := range diags.Warnings() {
+				warnings = append(warnings, w.Summary())
+			}
+			if diff := cmp.Diff(warnings, append([]string{}, tt.expectedWarningSummaries...)); diff != "" {
+				t.Fatalf("Warnings do not match: %s", diff)
+			}
+			if diff := cmp.Diff(tt.plan.KubernetesVersionUsed, tt.expectedKubernetesUsed); diff != "" {
+				t.Fatalf("Kubernetes version does not match: %s", diff)
+			}
+			if diff := cmp.Diff(tt.plan.NodePools, tt.expectedNodePools); diff != "" {
+				t.Fatalf("Node pools do not match: %s", diff)
+			}
+		})
+	}
+}
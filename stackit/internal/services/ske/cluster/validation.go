@@ -0,0 +1,155 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+const (
+	labelNameMaxLength   = 63
+	labelPrefixMaxLength = 253
+)
+
+var (
+	taintEffects = []string{
+		string(ske.TAINTEFFECT_NO_SCHEDULE),
+		string(ske.TAINTEFFECT_PREFER_NO_SCHEDULE),
+		string(ske.TAINTEFFECT_NO_EXECUTE),
+	}
+	// labelNameRegex matches the name segment of a Kubernetes label key and a label value
+	labelNameRegex = regexp.MustCompile(`^([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9]$`)
+	// labelPrefixRegex matches the optional DNS subdomain prefix of a Kubernetes label key
+	labelPrefixRegex = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+)
+
+// validateLabelKey checks a label or taint key against the Kubernetes label rules:
+// an optional DNS subdomain prefix of at most 253 characters, followed by a slash and a name of at most 63 characters.
+func validateLabelKey(key string) error {
+	name := key
+	if prefix, after, found := strings.Cut(key, "/"); found {
+		if prefix == "" || len(prefix) > labelPrefixMaxLength || !labelPrefixRegex.MatchString(prefix) {
+			return fmt.Errorf("prefix %q must be a lowercase DNS subdomain of at most %d characters", prefix, labelPrefixMaxLength)
+		}
+		name = after
+	}
+	if name == "" || len(name) > labelNameMaxLength || !labelNameRegex.MatchString(name) {
+		return fmt.Errorf("name %q must consist of at most %d alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character", name, labelNameMaxLength)
+	}
+	return nil
+}
+
+// validateLabelValue checks a label value against the Kubernetes label rules. Empty values are allowed.
+func validateLabelValue(value string) error {
+	if value == "" {
+		return nil
+	}
+	if len(value) > labelNameMaxLength || !labelNameRegex.MatchString(value) {
+		return fmt.Errorf("value %q must consist of at most %d alphanumeric characters, '-', '_' or '.', and must start and end with an alphanumeric character", value, labelNameMaxLength)
+	}
+	return nil
+}
+
+// validateNodePool checks the node pool configuration for mistakes that would otherwise only be reported by the SKE API.
+// Unknown values are skipped, they are validated once they are known.
+func validateNodePool(ctx context.Context, diags *diag.Diagnostics, np *nodePool) {
+	summary := fmt.Sprintf("Error configuring node pool %q", np.Name.ValueString())
+
+	if !utils.IsUndefined(np.Labels) {
+		labels := map[string]string{}
+		diags.Append(np.Labels.ElementsAs(ctx, &labels, true)...)
+		if diags.HasError() {
+			return
+		}
+		for key, value := range labels {
+			if err := validateLabelKey(key); err != nil {
+				core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Invalid label key %q: %v", key, err))
+			}
+			if err := validateLabelValue(value); err != nil {
+				core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Invalid value of label %q: %v", key, err))
+			}
+		}
+	}
+
+	if !utils.IsUndefined(np.Taints) {
+		taints := []taint{}
+		diags.Append(np.Taints.ElementsAs(ctx, &taints, true)...)
+		if diags.HasError() {
+			return
+		}
+		for _, t := range taints {
+			if !t.Effect.IsUnknown() && !slices.Contains(taintEffects, t.Effect.ValueString()) {
+				core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Invalid effect %q of taint %q. %s", t.Effect.ValueString(), t.Key.ValueString(), utils.FormatPossibleValues(taintEffects...)))
+			}
+			if !t.Key.IsUnknown() {
+				if err := validateLabelKey(t.Key.ValueString()); err != nil {
+					core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Invalid taint key %q: %v", t.Key.ValueString(), err))
+				}
+			}
+			if !utils.IsUndefined(t.Value) {
+				if err := validateLabelValue(t.Value.ValueString()); err != nil {
+					core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("Invalid value of taint %q: %v", t.Key.ValueString(), err))
+				}
+			}
+		}
+	}
+
+	validateNodePoolScaling(ctx, diags, np, summary)
+}
+
+// validateNodePoolScaling checks that minimum, maximum, max_surge and max_unavailable are consistent
+func validateNodePoolScaling(ctx context.Context, diags *diag.Diagnostics, np *nodePool, summary string) {
+	if np.Minimum.IsUnknown() || np.Maximum.IsUnknown() || np.MaxSurge.IsUnknown() || np.MaxUnavailable.IsUnknown() || np.AvailabilityZones.IsUnknown() {
+		return
+	}
+	minimum := np.Minimum.ValueInt64()
+	maximum := np.Maximum.ValueInt64()
+	if !np.Minimum.IsNull() && !np.Maximum.IsNull() && minimum > maximum {
+		core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`minimum` (%d) must not be larger than `maximum` (%d).", minimum, maximum))
+	}
+
+	maxSurge := np.MaxSurge.ValueInt64()
+	maxUnavailable := np.MaxUnavailable.ValueInt64()
+	// if both are unset, SKE applies its defaults
+	if !np.MaxSurge.IsNull() && !np.MaxUnavailable.IsNull() && maxSurge == 0 && maxUnavailable == 0 {
+		core.LogAndAddError(ctx, diags, summary, "`max_surge` and `max_unavailable` must not both be 0, otherwise nodes can't be updated.")
+	}
+	if !np.Maximum.IsNull() {
+		if maxSurge > maximum {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`max_surge` (%d) must not be larger than `maximum` (%d).", maxSurge, maximum))
+		}
+		if maxUnavailable > maximum {
+			core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`max_unavailable` (%d) must not be larger than `maximum` (%d).", maxUnavailable, maximum))
+		}
+	}
+
+	zones := len(np.AvailabilityZones.Elements())
+	if maxSurge > 0 && int64(zones) > maxSurge {
+		core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`max_surge` (%d) must be at least the amount of availability zones (%d).", maxSurge, zones))
+	}
+	if maxUnavailable > 0 && int64(zones) > maxUnavailable {
+		core.LogAndAddError(ctx, diags, summary, fmt.Sprintf("`max_unavailable` (%d) must be at least the amount of availability zones (%d).", maxUnavailable, zones))
+	}
+}
+
+// validateNodePools validates all node pools of the cluster configuration
+func validateNodePools(ctx context.Context, diags *diag.Diagnostics, model *Model) {
+	if utils.IsUndefined(model.NodePools) {
+		return
+	}
+	nodePools := []nodePool{}
+	diags.Append(model.NodePools.ElementsAs(ctx, &nodePools, true)...)
+	if diags.HasError() {
+		return
+	}
+	for i := range nodePools {
+		validateNodePool(ctx, diags, &nodePools[i])
+	}
+}
@@ -0,0 +1,221 @@
+package ske
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestValidateLabelKey(t *testing.T) {
+	tests := []struct {
+		key     string
+		isValid bool
+	}{
+		{"app", true},
+		{"node-role.kubernetes.io/worker", true},
+		{"example.com/my_label.v1", true},
+		{"a", true},
+		{strings.Repeat("a", 63), true},
+		{strings.Repeat("a", 64), false},
+		{"", false},
+		{"-app", false},
+		{"app-", false},
+		{"my label", false},
+		{"/app", false},
+		{"example.com/", false},
+		{"Example.com/app", false},
+		{"example.com/team/app", false},
+		{strings.Repeat("a", 254) + "/app", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			err := validateLabelKey(tt.key)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateLabelValue(t *testing.T) {
+	tests := []struct {
+		value   string
+		isValid bool
+	}{
+		{"", true},
+		{"value", true},
+		{"v1.2_3-4", true},
+		{strings.Repeat("a", 64), false},
+		{"value/other", false},
+		{"_value", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			err := validateLabelValue(tt.value)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateNodePool(t *testing.T) {
+	validNodePool := func(mods ...func(*nodePool)) *nodePool {
+		np := &nodePool{
+			Name:              types.StringValue("np"),
+			Minimum:           types.Int64Value(1),
+			Maximum:           types.Int64Value(3),
+			MaxSurge:          types.Int64Value(1),
+			MaxUnavailable:    types.Int64Null(),
+			Labels:            types.MapNull(types.StringType),
+			Taints:            types.ListNull(types.ObjectType{AttrTypes: taintTypes}),
+			AvailabilityZones: types.ListValueMust(types.StringType, []attr.Value{types.StringValue("eu01-1")}),
+		}
+		for _, mod := range mods {
+			mod(np)
+		}
+		return np
+	}
+	taintList := func(effect, key string) types.List {
+		return types.ListValueMust(types.ObjectType{AttrTypes: taintTypes}, []attr.Value{
+			types.ObjectValueMust(taintTypes, map[string]attr.Value{
+				"effect": types.StringValue(effect),
+				"key":    types.StringValue(key),
+				"value":  types.StringNull(),
+			}),
+		})
+	}
+
+	tests := []struct {
+		description string
+		input       *nodePool
+		isValid     bool
+	}{
+		{
+			"valid",
+			validNodePool(),
+			true,
+		},
+		{
+			"valid_labels_and_taints",
+			validNodePool(func(np *nodePool) {
+				np.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{
+					"example.com/team": types.StringValue("platform"),
+					"empty":            types.StringValue(""),
+				})
+				np.Taints = taintList("NoSchedule", "dedicated")
+			}),
+			true,
+		},
+		{
+			"unknown_values",
+			validNodePool(func(np *nodePool) {
+				np.Labels = types.MapUnknown(types.StringType)
+				np.Taints = types.ListUnknown(types.ObjectType{AttrTypes: taintTypes})
+				np.MaxSurge = types.Int64Unknown()
+				np.MaxUnavailable = types.Int64Unknown()
+			}),
+			true,
+		},
+		{
+			"invalid_label_key",
+			validNodePool(func(np *nodePool) {
+				np.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{
+					"my label": types.StringValue("value"),
+				})
+			}),
+			false,
+		},
+		{
+			"invalid_label_value",
+			validNodePool(func(np *nodePool) {
+				np.Labels = types.MapValueMust(types.StringType, map[string]attr.Value{
+					"key": types.StringValue("in valid"),
+				})
+			}),
+			false,
+		},
+		{
+			"invalid_taint_effect",
+			validNodePool(func(np *nodePool) {
+				np.Taints = taintList("NoScheduling", "dedicated")
+			}),
+			false,
+		},
+		{
+			"invalid_taint_key",
+			validNodePool(func(np *nodePool) {
+				np.Taints = taintList("NoExecute", "dedicated/")
+			}),
+			false,
+		},
+		{
+			"minimum_larger_than_maximum",
+			validNodePool(func(np *nodePool) {
+				np.Minimum = types.Int64Value(4)
+			}),
+			false,
+		},
+		{
+			"max_surge_and_max_unavailable_unset",
+			validNodePool(func(np *nodePool) {
+				np.MaxSurge = types.Int64Null()
+			}),
+			true,
+		},
+		{
+			"max_surge_and_max_unavailable_zero",
+			validNodePool(func(np *nodePool) {
+				np.MaxSurge = types.Int64Value(0)
+				np.MaxUnavailable = types.Int64Value(0)
+			}),
+			false,
+		},
+		{
+			"max_surge_larger_than_maximum",
+			validNodePool(func(np *nodePool) {
+				np.MaxSurge = types.Int64Value(4)
+			}),
+			false,
+		},
+		{
+			"max_unavailable_larger_than_maximum",
+			validNodePool(func(np *nodePool) {
+				np.MaxUnavailable = types.Int64Value(4)
+			}),
+			false,
+		},
+		{
+			"max_surge_less_than_zones",
+			validNodePool(func(np *nodePool) {
+				np.AvailabilityZones = types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("eu01-1"),
+					types.StringValue("eu01-2"),
+				})
+			}),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			var diags diag.Diagnostics
+			validateNodePool(context.Background(), &diags, tt.input)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
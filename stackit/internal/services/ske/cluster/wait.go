@@ -0,0 +1,112 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeWait "github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
+)
+
+const clusterReadyTimeout = 45 * time.Minute
+
+// permanentErrorCodes are the error codes of an unhealthy cluster that don't resolve without changes to the cluster
+// or its environment. Waiting for a cluster with one of these errors fails immediately instead of timing out.
+var permanentErrorCodes = []string{
+	string(ske.RUNTIMEERRORCODE_QUOTA_EXCEEDED),
+	string(ske.RUNTIMEERRORCODE_CONFIGURATION_PROBLEM),
+	string(ske.RUNTIMEERRORCODE_DNS_ZONE_NOT_FOUND),
+	string(ske.CLUSTERERRORCODE_DNS_ZONE_NOT_FOUND),
+	string(ske.CLUSTERERRORCODE_NODE_NO_VALID_HOST_FOUND),
+	string(ske.CLUSTERERRORCODE_NODE_MACHINE_TYPE_NOT_FOUND),
+	string(ske.CLUSTERERRORCODE_INFRA_SNA_NETWORK_NOT_FOUND),
+}
+
+type clusterErrorStatus struct {
+	code    string
+	message string
+}
+
+// getClusterErrors returns the runtime error and the errors reported in the status of the cluster
+func getClusterErrors(cl *ske.Cluster) []clusterErrorStatus {
+	if cl == nil || cl.Status == nil {
+		return nil
+	}
+	errs := []clusterErrorStatus{}
+	if e := cl.Status.Error; e != nil && (e.Code != nil || e.Message != nil) {
+		errs = append(errs, clusterErrorStatus{
+			code:    string(e.GetCode()),
+			message: e.GetMessage(),
+		})
+	}
+	for _, e := range cl.Status.GetErrors() {
+		code := string(e.GetCode())
+		if slices.ContainsFunc(errs, func(existing clusterErrorStatus) bool {
+			return existing.code == code && existing.message == e.GetMessage()
+		}) {
+			continue
+		}
+		errs = append(errs, clusterErrorStatus{
+			code:    code,
+			message: e.GetMessage(),
+		})
+	}
+	return errs
+}
+
+// formatClusterErrors formats the errors of the cluster as "CODE: message" list
+func formatClusterErrors(errs []clusterErrorStatus) string {
+	messages := []string{}
+	for _, e := range errs {
+		switch {
+		case e.code != "" && e.message != "":
+			messages = append(messages, fmt.Sprintf("%s: %s", e.code, e.message))
+		case e.code != "":
+			messages = append(messages, e.code)
+		default:
+			messages = append(messages, e.message)
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// clusterReadyWaitHandler waits for the cluster to be healthy or hibernated, like the CreateOrUpdateClusterWaitHandler of the SDK.
+// An unhealthy cluster with a permanent error fails the wait right away, with the error codes and messages of the cluster.
+func clusterReadyWaitHandler(ctx context.Context, a skeClient, projectId, region, name string) *wait.AsyncActionHandler[ske.Cluster] {
+	handler := wait.New(func() (waitFinished bool, response *ske.Cluster, err error) {
+		cl, err := a.GetClusterExecute(ctx, projectId, region, name)
+		if err != nil {
+			return false, nil, err
+		}
+		if cl.Status == nil || cl.Status.Aggregated == nil {
+			return false, nil, nil
+		}
+		state := *cl.Status.Aggregated
+		errs := getClusterErrors(cl)
+
+		switch {
+		case state == ske.CLUSTERSTATUSSTATE_HEALTHY || state == ske.CLUSTERSTATUSSTATE_HIBERNATED:
+			return true, cl, nil
+		case string(state) == skeWait.StateFailed:
+			return true, cl, fmt.Errorf("cluster failed: %s", formatClusterErrors(errs))
+		case state != ske.CLUSTERSTATUSSTATE_UNHEALTHY:
+			return false, nil, nil
+		}
+
+		// The cluster stays impaired with an invalid observability instance, but it is usable
+		if cl.Status.Error != nil && cl.Status.Error.GetCode() == ske.RUNTIMEERRORCODE_OBSERVABILITY_INSTANCE_NOT_FOUND {
+			return true, cl, nil
+		}
+		// Unhealthy clusters usually recover, e.g. during the creation, except for permanent errors
+		if slices.ContainsFunc(errs, func(e clusterErrorStatus) bool { return slices.Contains(permanentErrorCodes, e.code) }) {
+			return true, cl, fmt.Errorf("cluster is unhealthy: %s", formatClusterErrors(errs))
+		}
+		return false, nil, nil
+	})
+	handler.SetTimeout(clusterReadyTimeout)
+	return handler
+}
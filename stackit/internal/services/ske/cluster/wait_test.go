@@ -0,0 +1,146 @@
+package ske
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestClusterReadyWaitHandler(t *testing.T) {
+	tests := []struct {
+		description   string
+		status        *ske.ClusterStatus
+		getFails      bool
+		wantResp      bool
+		wantErr       bool
+		wantErrSubstr string
+	}{
+		{
+			description: "healthy",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_HEALTHY.Ptr(),
+			},
+			wantResp: true,
+		},
+		{
+			description: "hibernated",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_HIBERNATED.Ptr(),
+			},
+			wantResp: true,
+		},
+		{
+			description: "unhealthy_invalid_observability_instance",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_UNHEALTHY.Ptr(),
+				Error: &ske.RuntimeError{
+					Code:    ske.RUNTIMEERRORCODE_OBSERVABILITY_INSTANCE_NOT_FOUND.Ptr(),
+					Message: utils.Ptr("observability instance not found"),
+				},
+			},
+			wantResp: true,
+		},
+		{
+			description: "unhealthy_permanent_error",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_UNHEALTHY.Ptr(),
+				Errors: &[]ske.ClusterError{
+					{
+						Code:    ske.CLUSTERERRORCODE_DNS_ZONE_NOT_FOUND.Ptr(),
+						Message: utils.Ptr("dns zone not found"),
+					},
+				},
+			},
+			wantErr:       true,
+			wantErrSubstr: "SKE_DNS_ZONE_NOT_FOUND: dns zone not found",
+		},
+		{
+			description: "unhealthy_quota_exceeded",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_UNHEALTHY.Ptr(),
+				Error: &ske.RuntimeError{
+					Code:    ske.RUNTIMEERRORCODE_QUOTA_EXCEEDED.Ptr(),
+					Message: utils.Ptr("quota exceeded"),
+				},
+			},
+			wantErr:       true,
+			wantErrSubstr: "SKE_QUOTA_EXCEEDED: quota exceeded",
+		},
+		{
+			description: "unhealthy_temporary_error_times_out",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_UNHEALTHY.Ptr(),
+				Error: &ske.RuntimeError{
+					Code: ske.RUNTIMEERRORCODE_RATE_LIMITS.Ptr(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			description: "creating_times_out",
+			status: &ske.ClusterStatus{
+				Aggregated: ske.CLUSTERSTATUSSTATE_CREATING.Ptr(),
+			},
+			wantErr: true,
+		},
+		{
+			description: "get_fails",
+			getFails:    true,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &skeClientMocked{
+				returnError: tt.getFails,
+				getClusterResp: &ske.Cluster{
+					Name:   utils.Ptr("name"),
+					Status: tt.status,
+				},
+			}
+			handler := clusterReadyWaitHandler(context.Background(), client, "pid", testRegion, "name")
+			handler.SetThrottle(time.Millisecond).SetTempErrRetryLimit(0).SetTimeout(20 * time.Millisecond)
+			resp, err := handler.WaitWithContext(context.Background())
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("handler error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErrSubstr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErrSubstr)) {
+				t.Fatalf("expected error to contain %q, got %v", tt.wantErrSubstr, err)
+			}
+			if tt.wantResp && resp == nil {
+				t.Fatalf("expected a cluster response")
+			}
+		})
+	}
+}
+
+func TestFormatClusterErrors(t *testing.T) {
+	cluster := &ske.Cluster{
+		Status: &ske.ClusterStatus{
+			Error: &ske.RuntimeError{
+				Code:    ske.RUNTIMEERRORCODE_DNS_ZONE_NOT_FOUND.Ptr(),
+				Message: utils.Ptr("dns zone not found"),
+			},
+			Errors: &[]ske.ClusterError{
+				{
+					Code:    ske.CLUSTERERRORCODE_DNS_ZONE_NOT_FOUND.Ptr(),
+					Message: utils.Ptr("dns zone not found"),
+				},
+				{
+					Code: ske.CLUSTERERRORCODE_NODE_MISCONFIGURED_PDB.Ptr(),
+				},
+				{
+					Message: utils.Ptr("something went wrong"),
+				},
+			},
+		},
+	}
+	expected := "SKE_DNS_ZONE_NOT_FOUND: dns zone not found; SKE_NODE_MISCONFIGURED_PDB; something went wrong"
+	if output := formatClusterErrors(getClusterErrors(cluster)); output != expected {
+		t.Fatalf("expected %q, got %q", expected, output)
+	}
+}
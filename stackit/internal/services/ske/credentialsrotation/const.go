@@ -0,0 +1,20 @@
+package ske
+
+const markdownDescription = `
+## Example Usage` + "\n" + `
+
+### Rotate the cluster credentials yearly` + "\n" +
+	"```terraform" + `
+resource "time_rotating" "rotate" {
+  rotation_days = 365
+}
+
+resource "stackit_ske_cluster_credentials_rotation" "rotation" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name = "example-name"
+
+  rotate_when_changed = {
+    rotation = time_rotating.rotate.id
+  }
+}
+` + "\n```"
@@ -0,0 +1,357 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske/wait"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &credentialsRotationResource{}
+	_ resource.ResourceWithConfigure  = &credentialsRotationResource{}
+	_ resource.ResourceWithModifyPlan = &credentialsRotationResource{}
+)
+
+type Model struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId          types.String `tfsdk:"project_id"`
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	Region             types.String `tfsdk:"region"`
+	RotateWhenChanged  types.Map    `tfsdk:"rotate_when_changed"`
+	Phase              types.String `tfsdk:"phase"`
+	LastInitiationTime types.String `tfsdk:"last_initiation_time"`
+	LastCompletionTime types.String `tfsdk:"last_completion_time"`
+}
+
+// NewCredentialsRotationResource is a helper function to simplify the provider implementation.
+func NewCredentialsRotationResource() resource.Resource {
+	return &credentialsRotationResource{}
+}
+
+// credentialsRotationResource is the resource implementation.
+type credentialsRotationResource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *credentialsRotationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_cluster_credentials_rotation"
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *credentialsRotationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *credentialsRotationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                 "SKE cluster credentials rotation resource schema. Creating the resource rotates the cluster CA and service account keys: the rotation is started, the provider waits for the cluster to reconcile and then completes the rotation. Kubeconfigs obtained before the rotation are invalid afterwards, `stackit_ske_kubeconfig` resources are renewed on their next refresh.",
+		"id":                   "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cluster_name`\".",
+		"project_id":           "STACKIT project ID to which the cluster is associated.",
+		"cluster_name":         "Name of the SKE cluster.",
+		"region":               "The resource region. If not defined, the provider region is used.",
+		"rotate_when_changed":  "A map of arbitrary key/value pairs that triggers a new credentials rotation when changed, e.g. the ID of a `time_rotating` resource. Modifying this map triggers the creation of a new resource.",
+		"phase":                "Phase of the credentials rotation.",
+		"last_initiation_time": "Date-time when the last credentials rotation was started.",
+		"last_completion_time": "Date-time when the last credentials rotation was completed.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: fmt.Sprintf("%s%s", descriptions["main"], markdownDescription),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: descriptions["cluster_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotate_when_changed": schema.MapAttribute{
+				Description: descriptions["rotate_when_changed"],
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"phase": schema.StringAttribute{
+				Description: descriptions["phase"],
+				Computed:    true,
+			},
+			"last_initiation_time": schema.StringAttribute{
+				Description: descriptions["last_initiation_time"],
+				Computed:    true,
+			},
+			"last_completion_time": schema.StringAttribute{
+				Description: descriptions["last_completion_time"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *credentialsRotationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create rotates the cluster credentials and sets the initial Terraform state.
+func (r *credentialsRotationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	cluster, err := r.client.GetClusterExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	// A rotation that was started before, e.g. by a failed apply, is continued instead of starting a new one
+	phase := getPhase(cluster)
+	if phase != ske.CREDENTIALSROTATIONSTATEPHASE_PREPARING && phase != ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED {
+		_, err = r.client.StartCredentialsRotationExecute(ctx, projectId, region, clusterName)
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", fmt.Sprintf("Starting credentials rotation: %v", err))
+			return
+		}
+		tflog.Info(ctx, "SKE cluster credentials rotation started")
+	}
+
+	waitCtx := core.InitWaitProgress(ctx, "cluster")
+	_, err = wait.StartCredentialsRotationWaitHandler(waitCtx, r.client, projectId, region, clusterName).WaitWithContext(waitCtx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for credentials rotation to be prepared: %v", err)))
+		return
+	}
+
+	waitCtx = core.InitWaitProgress(ctx, "cluster")
+	_, err = wait.RotateCredentialsWaitHandler(waitCtx, r.client, projectId, region, clusterName).WaitWithContext(waitCtx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for cluster reconciliation: %v", err)))
+		return
+	}
+
+	_, err = r.client.CompleteCredentialsRotationExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", fmt.Sprintf("Completing credentials rotation: %v", err))
+		return
+	}
+
+	waitCtx = core.InitWaitProgress(ctx, "cluster")
+	cluster, err = wait.CompleteCredentialsRotationWaitHandler(waitCtx, r.client, projectId, region, clusterName).WaitWithContext(waitCtx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Waiting for credentials rotation to be completed: %v", err)))
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error rotating cluster credentials", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE cluster credentials rotated")
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *credentialsRotationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	cluster, err := r.client.GetClusterExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			"Reading cluster credentials rotation",
+			fmt.Sprintf("Cluster with name %q does not exist in project %q.", clusterName, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading cluster credentials rotation", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE cluster credentials rotation read")
+}
+
+// Update shouldn't be called, all attributes require replacement.
+func (r *credentialsRotationResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating cluster credentials rotation", "Cluster credentials rotation can't be updated")
+}
+
+// Delete removes the resource from the Terraform state. The rotated credentials are not affected.
+func (r *credentialsRotationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "cluster_name", model.ClusterName.ValueString())
+	ctx = tflog.SetField(ctx, "region", model.Region.ValueString())
+
+	// the credentials rotation is removed from the state automatically
+	tflog.Info(ctx, "SKE cluster credentials rotation deleted")
+}
+
+func getPhase(cluster *ske.Cluster) ske.CredentialsRotationStatePhase {
+	if cluster == nil || cluster.Status == nil || cluster.Status.CredentialsRotation == nil || cluster.Status.CredentialsRotation.Phase == nil {
+		return ""
+	}
+	return *cluster.Status.CredentialsRotation.Phase
+}
+
+func mapFields(cluster *ske.Cluster, model *Model, region string) error {
+	if cluster == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ClusterName.ValueString())
+	model.Region = types.StringValue(region)
+
+	model.Phase = types.StringNull()
+	model.LastInitiationTime = types.StringNull()
+	model.LastCompletionTime = types.StringNull()
+	if cluster.Status == nil || cluster.Status.CredentialsRotation == nil {
+		return nil
+	}
+	rotation := cluster.Status.CredentialsRotation
+	if rotation.Phase != nil {
+		model.Phase = types.StringValue(string(*rotation.Phase))
+	}
+	if rotation.LastInitiationTime != nil {
+		model.LastInitiationTime = types.StringValue(rotation.LastInitiationTime.Format(time.RFC3339))
+	}
+	if rotation.LastCompletionTime != nil {
+		model.LastCompletionTime = types.StringValue(rotation.LastCompletionTime.Format(time.RFC3339))
+	}
+	return nil
+}
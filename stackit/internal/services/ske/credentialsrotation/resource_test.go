@@ -0,0 +1,126 @@
+package ske
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+func TestMapFields(t *testing.T) {
+	initiationTime := time.Date(2025, 2, 1, 10, 0, 0, 0, time.UTC)
+	completionTime := time.Date(2025, 2, 1, 10, 30, 0, 0, time.UTC)
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&ske.Cluster{},
+			Model{
+				Id:                 types.StringValue("pid,eu01,name"),
+				ProjectId:          types.StringValue("pid"),
+				ClusterName:        types.StringValue("name"),
+				Region:             types.StringValue("eu01"),
+				RotateWhenChanged:  types.MapNull(types.StringType),
+				Phase:              types.StringNull(),
+				LastInitiationTime: types.StringNull(),
+				LastCompletionTime: types.StringNull(),
+			},
+			true,
+		},
+		{
+			"completed_rotation",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					CredentialsRotation: &ske.CredentialsRotationState{
+						Phase:              ske.CREDENTIALSROTATIONSTATEPHASE_COMPLETED.Ptr(),
+						LastInitiationTime: &initiationTime,
+						LastCompletionTime: &completionTime,
+					},
+				},
+			},
+			Model{
+				Id:                 types.StringValue("pid,eu01,name"),
+				ProjectId:          types.StringValue("pid"),
+				ClusterName:        types.StringValue("name"),
+				Region:             types.StringValue("eu01"),
+				RotateWhenChanged:  types.MapNull(types.StringType),
+				Phase:              types.StringValue("COMPLETED"),
+				LastInitiationTime: types.StringValue("2025-02-01T10:00:00Z"),
+				LastCompletionTime: types.StringValue("2025-02-01T10:30:00Z"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId:         tt.expected.ProjectId,
+				ClusterName:       tt.expected.ClusterName,
+				RotateWhenChanged: types.MapNull(types.StringType),
+			}
+			err := mapFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPhase(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		expected    ske.CredentialsRotationStatePhase
+	}{
+		{
+			"no status",
+			&ske.Cluster{},
+			"",
+		},
+		{
+			"prepared",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					CredentialsRotation: &ske.CredentialsRotationState{
+						Phase: ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED.Ptr(),
+					},
+				},
+			},
+			ske.CREDENTIALSROTATIONSTATEPHASE_PREPARED,
+		},
+		{
+			"nil cluster",
+			nil,
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			phase := getPhase(tt.input)
+			if phase != tt.expected {
+				t.Fatalf("expected %q, got %q", tt.expected, phase)
+			}
+		})
+	}
+}
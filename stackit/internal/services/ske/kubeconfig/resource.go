@@ -10,6 +10,8 @@ import (
 	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
 
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
@@ -23,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
@@ -31,22 +34,37 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource               = &kubeconfigResource{}
-	_ resource.ResourceWithConfigure  = &kubeconfigResource{}
-	_ resource.ResourceWithModifyPlan = &kubeconfigResource{}
+	_ resource.Resource                   = &kubeconfigResource{}
+	_ resource.ResourceWithConfigure      = &kubeconfigResource{}
+	_ resource.ResourceWithModifyPlan     = &kubeconfigResource{}
+	_ resource.ResourceWithValidateConfig = &kubeconfigResource{}
 )
 
+const (
+	// KubeconfigFormatAdmin is a short-lived admin kubeconfig containing client credentials
+	KubeconfigFormatAdmin = "admin"
+	// KubeconfigFormatLogin is a kubeconfig without credentials, which obtains them via the STACKIT CLI
+	KubeconfigFormatLogin = "login"
+)
+
+var kubeconfigFormats = []string{KubeconfigFormatAdmin, KubeconfigFormatLogin}
+
+// defaultExpiration is the expiration of admin kubeconfigs in seconds, if none is configured
+const defaultExpiration = 3600
+
 type Model struct {
-	Id           types.String `tfsdk:"id"` // needed by TF
-	ClusterName  types.String `tfsdk:"cluster_name"`
-	ProjectId    types.String `tfsdk:"project_id"`
-	KubeconfigId types.String `tfsdk:"kube_config_id"` // uuid generated internally because kubeconfig has no identifier
-	Kubeconfig   types.String `tfsdk:"kube_config"`
-	Expiration   types.Int64  `tfsdk:"expiration"`
-	Refresh      types.Bool   `tfsdk:"refresh"`
-	ExpiresAt    types.String `tfsdk:"expires_at"`
-	CreationTime types.String `tfsdk:"creation_time"`
-	Region       types.String `tfsdk:"region"`
+	Id               types.String `tfsdk:"id"` // needed by TF
+	ClusterName      types.String `tfsdk:"cluster_name"`
+	ProjectId        types.String `tfsdk:"project_id"`
+	KubeconfigId     types.String `tfsdk:"kube_config_id"` // uuid generated internally because kubeconfig has no identifier
+	Kubeconfig       types.String `tfsdk:"kube_config"`
+	Expiration       types.Int64  `tfsdk:"expiration"`
+	Refresh          types.Bool   `tfsdk:"refresh"`
+	RefreshBefore    types.String `tfsdk:"refresh_before"`
+	KubeconfigFormat types.String `tfsdk:"kubeconfig_format"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	CreationTime     types.String `tfsdk:"creation_time"`
+	Region           types.String `tfsdk:"region"`
 }
 
 // NewKubeconfigResource is a helper function to simplify the provider implementation.
@@ -84,17 +102,19 @@ func (r *kubeconfigResource) Configure(ctx context.Context, req resource.Configu
 // Schema defines the schema for the resource.
 func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	descriptions := map[string]string{
-		"main":           "SKE kubeconfig resource schema. Must have a `region` specified in the provider configuration.",
-		"id":             "Terraform's internal resource ID. It is structured as \"`project_id`,`cluster_name`,`kube_config_id`\".",
-		"kube_config_id": "Internally generated UUID to identify a kubeconfig resource in Terraform, since the SKE API doesnt return a kubeconfig identifier",
-		"cluster_name":   "Name of the SKE cluster.",
-		"project_id":     "STACKIT project ID to which the cluster is associated.",
-		"kube_config":    "Raw short-lived admin kubeconfig.",
-		"expiration":     "Expiration time of the kubeconfig, in seconds. Defaults to `3600`",
-		"expires_at":     "Timestamp when the kubeconfig expires",
-		"refresh":        "If set to true, the provider will check if the kubeconfig has expired and will generated a new valid one in-place",
-		"creation_time":  "Date-time when the kubeconfig was created",
-		"region":         "The resource region. If not defined, the provider region is used.",
+		"main":              "SKE kubeconfig resource schema. Must have a `region` specified in the provider configuration.",
+		"id":                "Terraform's internal resource ID. It is structured as \"`project_id`,`cluster_name`,`kube_config_id`\".",
+		"kube_config_id":    "Internally generated UUID to identify a kubeconfig resource in Terraform, since the SKE API doesnt return a kubeconfig identifier",
+		"cluster_name":      "Name of the SKE cluster.",
+		"project_id":        "STACKIT project ID to which the cluster is associated.",
+		"kube_config":       "Raw kubeconfig. Depending on `kubeconfig_format`, either a short-lived admin kubeconfig or a login kubeconfig without credentials.",
+		"expiration":        "Expiration time of the kubeconfig, in seconds. Defaults to `3600`",
+		"expires_at":        "Timestamp when the kubeconfig expires",
+		"refresh":           "If set to true, the provider will check if the kubeconfig has expired and will generated a new valid one in-place",
+		"refresh_before":    "Duration before `expires_at`, e.g. `24h`, within which the kubeconfig is replaced by a new one. Avoids kubeconfigs expiring during an apply. Must be shorter than `expiration`.",
+		"kubeconfig_format": fmt.Sprintf("Format of the kubeconfig. `%s` returns a short-lived admin kubeconfig, `%s` returns a kubeconfig that obtains short-lived credentials via the STACKIT CLI and has no expiration. %s Defaults to `%s`.", KubeconfigFormatAdmin, KubeconfigFormatLogin, utils.FormatPossibleValues(kubeconfigFormats...), KubeconfigFormatAdmin),
+		"creation_time":     "Date-time when the kubeconfig was created",
+		"region":            "The resource region. If not defined, the provider region is used.",
 	}
 
 	resp.Schema = schema.Schema{
@@ -141,7 +161,7 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: descriptions["expiration"],
 				Optional:    true,
 				Computed:    true,
-				Default:     int64default.StaticInt64(3600), // the default value is not returned by the API so we set a default value here, otherwise we would have to compute the expiration based on the expires_at field
+				Default:     int64default.StaticInt64(defaultExpiration), // the default value is not returned by the API so we set a default value here, otherwise we would have to compute the expiration based on the expires_at field
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
 					int64planmodifier.UseStateForUnknown(),
@@ -154,6 +174,32 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					boolplanmodifier.RequiresReplace(),
 				},
 			},
+			"refresh_before": schema.StringAttribute{
+				Description: descriptions["refresh_before"],
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.ValidDurationString(),
+				},
+			},
+			"kubeconfig_format": schema.StringAttribute{
+				Description: descriptions["kubeconfig_format"],
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(KubeconfigFormatAdmin),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						kubeconfigFormatChanged,
+						"Changing the kubeconfig format requires a new kubeconfig.",
+						"Changing the kubeconfig format requires a new kubeconfig.",
+					),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(kubeconfigFormats...),
+				},
+			},
 			"kube_config": schema.StringAttribute{
 				Description: descriptions["kube_config"],
 				Computed:    true,
@@ -189,6 +235,19 @@ func (r *kubeconfigResource) Schema(_ context.Context, _ resource.SchemaRequest,
 	}
 }
 
+// ValidateConfig checks that refresh_before is shorter than the expiration of the kubeconfig.
+func (r *kubeconfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var model Model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := validateRefreshBefore(&model); err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring kubeconfig", err.Error())
+	}
+}
+
 // ModifyPlan will be called in the Plan phase and will check if the plan is a creation of the resource
 // If so, show warning related to deprecated credentials endpoints
 func (r *kubeconfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
@@ -217,6 +276,30 @@ func (r *kubeconfigResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
+		var stateModel Model
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateModel)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		// the refresh window is checked against the stored expiration and the configured refresh_before
+		stateModel.RefreshBefore = planModel.RefreshBefore
+		expiresSoon, err := checkExpiresWithin(&stateModel, time.Now())
+		if err != nil {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error planning kubeconfig", fmt.Sprintf("%v", err))
+			return
+		}
+		if expiresSoon {
+			tflog.Info(ctx, "SKE kubeconfig expires within the refresh_before window, planning replacement")
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expires_at"))
+			planModel.Id = types.StringUnknown()
+			planModel.KubeconfigId = types.StringUnknown()
+			planModel.Kubeconfig = types.StringUnknown()
+			planModel.ExpiresAt = types.StringUnknown()
+			planModel.CreationTime = types.StringUnknown()
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -260,6 +343,7 @@ func (r *kubeconfigResource) Create(ctx context.Context, req resource.CreateRequ
 // Read refreshes the Terraform state with the latest data.
 // There is no GET kubeconfig endpoint.
 // If the refresh field is set, Read will check the expiration date and will get a new valid kubeconfig if it has expired
+// A kubeconfig expiring within the refresh_before window is replaced in the plan, see ModifyPlan.
 // If kubeconfig creation time is before lastCompletionTime of the credentials rotation or
 // before cluster creation time a new kubeconfig is created.
 func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
@@ -334,6 +418,10 @@ func (r *kubeconfigResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *kubeconfigResource) createKubeconfig(ctx context.Context, model *Model) error {
+	if model.KubeconfigFormat.ValueString() == KubeconfigFormatLogin {
+		return r.createLoginKubeconfig(ctx, model)
+	}
+
 	// Generate API request body from model
 	payload, err := toCreatePayload(model)
 	if err != nil {
@@ -353,9 +441,37 @@ func (r *kubeconfigResource) createKubeconfig(ctx context.Context, model *Model)
 	return nil
 }
 
-func (r *kubeconfigResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
-	// Update shouldn't be called
-	core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating kubeconfig", "Kubeconfig can't be updated")
+func (r *kubeconfigResource) createLoginKubeconfig(ctx context.Context, model *Model) error {
+	kubeconfigResp, err := r.client.GetLoginKubeconfigExecute(ctx, model.ProjectId.ValueString(), model.Region.ValueString(), model.ClusterName.ValueString())
+	if err != nil {
+		return fmt.Errorf("calling API: %w", err)
+	}
+
+	// Map response body to schema
+	err = mapLoginFields(kubeconfigResp, model, time.Now())
+	if err != nil {
+		return fmt.Errorf("processing API payload: %w", err)
+	}
+	return nil
+}
+
+// Update only stores the kubeconfig format of kubeconfigs created before the format was introduced.
+// All other changes require a new kubeconfig.
+func (r *kubeconfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if model.KubeconfigFormat.ValueString() != KubeconfigFormatAdmin {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating kubeconfig", "Kubeconfig can't be updated")
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE kubeconfig updated")
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
@@ -406,6 +522,29 @@ func mapFields(kubeconfigResp *ske.Kubeconfig, model *Model, creationTime time.T
 	return nil
 }
 
+func mapLoginFields(kubeconfigResp *ske.LoginKubeconfig, model *Model, creationTime time.Time) error {
+	if kubeconfigResp == nil {
+		return fmt.Errorf("response is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(
+		model.ProjectId.ValueString(), model.ClusterName.ValueString(), model.KubeconfigId.ValueString(),
+	)
+
+	if kubeconfigResp.Kubeconfig == nil {
+		return fmt.Errorf("kubeconfig not present")
+	}
+
+	model.Kubeconfig = types.StringPointerValue(kubeconfigResp.Kubeconfig)
+	// login kubeconfigs don't contain credentials and therefore don't expire
+	model.ExpiresAt = types.StringNull()
+	model.CreationTime = types.StringValue(creationTime.Format(time.RFC3339))
+	return nil
+}
+
 func toCreatePayload(model *Model) (*ske.CreateKubeconfigPayload, error) {
 	if model == nil {
 		return nil, fmt.Errorf("nil model")
@@ -440,6 +579,60 @@ func checkHasExpired(model *Model, currentTime time.Time) (bool, error) {
 	return false, nil
 }
 
+// kubeconfigFormatChanged requires a replacement if the format changed. Kubeconfigs created before the format
+// was introduced have no format in the state and are admin kubeconfigs.
+func kubeconfigFormatChanged(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) { // nolint:gocritic // function signature required by Terraform
+	stateFormat := req.StateValue.ValueString()
+	if req.StateValue.IsNull() {
+		stateFormat = KubeconfigFormatAdmin
+	}
+	resp.RequiresReplace = stateFormat != req.PlanValue.ValueString()
+}
+
+// helper function to check that refresh_before is shorter than the expiration of the kubeconfig,
+// otherwise a new kubeconfig would already be within the refresh window and be replaced on every plan
+func validateRefreshBefore(model *Model) error {
+	if model.RefreshBefore.IsNull() || model.RefreshBefore.IsUnknown() || model.Expiration.IsUnknown() {
+		return nil
+	}
+	// login kubeconfigs don't expire
+	if model.KubeconfigFormat.ValueString() == KubeconfigFormatLogin {
+		return nil
+	}
+	refreshBefore, err := time.ParseDuration(model.RefreshBefore.ValueString())
+	if err != nil {
+		// invalid durations are reported by the attribute validator
+		return nil
+	}
+	expiration := int64(defaultExpiration)
+	if !model.Expiration.IsNull() {
+		expiration = model.Expiration.ValueInt64()
+	}
+	if refreshBefore >= time.Duration(expiration)*time.Second {
+		return fmt.Errorf("`refresh_before` (%s) must be shorter than the `expiration` of the kubeconfig (%d seconds), otherwise the kubeconfig is replaced on every plan", model.RefreshBefore.ValueString(), expiration)
+	}
+	return nil
+}
+
+// helper function to check if the kubeconfig expires within the refresh_before window
+func checkExpiresWithin(model *Model, currentTime time.Time) (bool, error) {
+	if model.RefreshBefore.IsNull() || model.RefreshBefore.IsUnknown() {
+		return false, nil
+	}
+	if model.ExpiresAt.IsNull() || model.ExpiresAt.IsUnknown() {
+		return false, nil
+	}
+	refreshBefore, err := time.ParseDuration(model.RefreshBefore.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("converting refresh_before field to duration: %w", err)
+	}
+	expiresAt, err := time.Parse(time.RFC3339, model.ExpiresAt.ValueString())
+	if err != nil {
+		return false, fmt.Errorf("converting expiresAt field to timestamp: %w", err)
+	}
+	return expiresAt.Before(currentTime.Add(refreshBefore)), nil
+}
+
 // helper function to check if a credentials rotation was done
 func checkCredentialsRotation(cluster *ske.Cluster, model *Model) (bool, error) {
 	creationTimeValue := model.CreationTime
@@ -80,6 +80,66 @@ func TestMapFields(t *testing.T) {
 	}
 }
 
+func TestMapLoginFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.LoginKubeconfig
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"simple_values",
+			&ske.LoginKubeconfig{
+				Kubeconfig: utils.Ptr("kubeconfig"),
+			},
+			Model{
+				ClusterName:      types.StringValue("name"),
+				ProjectId:        types.StringValue("pid"),
+				KubeconfigFormat: types.StringValue(KubeconfigFormatLogin),
+				Kubeconfig:       types.StringValue("kubeconfig"),
+				ExpiresAt:        types.StringNull(),
+				CreationTime:     types.StringValue("2024-02-05T14:40:12Z"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+		{
+			"no_kubeconfig_field",
+			&ske.LoginKubeconfig{},
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			state := &Model{
+				ProjectId:        tt.expected.ProjectId,
+				ClusterName:      tt.expected.ClusterName,
+				KubeconfigFormat: tt.expected.KubeconfigFormat,
+			}
+			creationTime, _ := time.Parse(time.RFC3339, tt.expected.CreationTime.ValueString())
+			err := mapLoginFields(tt.input, state, creationTime)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(state, &tt.expected, cmpopts.IgnoreFields(Model{}, "Id"))
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestToCreatePayload(t *testing.T) {
 	tests := []struct {
 		description string
@@ -192,6 +252,162 @@ func TestCheckHasExpired(t *testing.T) {
 	}
 }
 
+func TestCheckExpiresWithin(t *testing.T) {
+	currentTime := time.Date(2024, 2, 7, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		description   string
+		inputModel    *Model
+		expected      bool
+		expectedError bool
+	}{
+		{
+			description: "expires within window",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				ExpiresAt:     types.StringValue(currentTime.Add(12 * time.Hour).Format(time.RFC3339)),
+			},
+			expected: true,
+		},
+		{
+			description: "already expired",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("1h"),
+				ExpiresAt:     types.StringValue(currentTime.Add(-1 * time.Hour).Format(time.RFC3339)),
+			},
+			expected: true,
+		},
+		{
+			description: "expires after window",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				ExpiresAt:     types.StringValue(currentTime.Add(48 * time.Hour).Format(time.RFC3339)),
+			},
+			expected: false,
+		},
+		{
+			description: "refresh_before not set",
+			inputModel: &Model{
+				RefreshBefore: types.StringNull(),
+				ExpiresAt:     types.StringValue(currentTime.Add(-1 * time.Hour).Format(time.RFC3339)),
+			},
+			expected: false,
+		},
+		{
+			description: "login kubeconfig without expiration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				ExpiresAt:     types.StringNull(),
+			},
+			expected: false,
+		},
+		{
+			description: "invalid duration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("one day"),
+				ExpiresAt:     types.StringValue(currentTime.Format(time.RFC3339)),
+			},
+			expectedError: true,
+		},
+		{
+			description: "invalid time",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				ExpiresAt:     types.StringValue("invalid time"),
+			},
+			expectedError: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			got, err := checkExpiresWithin(tt.inputModel, currentTime)
+			if (err != nil) != tt.expectedError {
+				t.Errorf("checkExpiresWithin() error = %v, expectedError %v", err, tt.expectedError)
+				return
+			}
+			if got != tt.expected {
+				t.Errorf("checkExpiresWithin() = %v, expected %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestValidateRefreshBefore(t *testing.T) {
+	tests := []struct {
+		description string
+		inputModel  *Model
+		isValid     bool
+	}{
+		{
+			description: "shorter than expiration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("12h"),
+				Expiration:    types.Int64Value(86400),
+			},
+			isValid: true,
+		},
+		{
+			description: "longer than expiration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				Expiration:    types.Int64Value(43200),
+			},
+			isValid: false,
+		},
+		{
+			description: "equal to expiration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("1h"),
+				Expiration:    types.Int64Value(3600),
+			},
+			isValid: false,
+		},
+		{
+			description: "longer than default expiration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				Expiration:    types.Int64Null(),
+			},
+			isValid: false,
+		},
+		{
+			description: "unknown expiration",
+			inputModel: &Model{
+				RefreshBefore: types.StringValue("24h"),
+				Expiration:    types.Int64Unknown(),
+			},
+			isValid: true,
+		},
+		{
+			description: "refresh_before not set",
+			inputModel: &Model{
+				RefreshBefore: types.StringNull(),
+				Expiration:    types.Int64Value(60),
+			},
+			isValid: true,
+		},
+		{
+			description: "login kubeconfig",
+			inputModel: &Model{
+				RefreshBefore:    types.StringValue("24h"),
+				Expiration:       types.Int64Null(),
+				KubeconfigFormat: types.StringValue(KubeconfigFormatLogin),
+			},
+			isValid: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			err := validateRefreshBefore(tt.inputModel)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
+
 func TestCheckCredentialsRotation(t *testing.T) {
 	tests := []struct {
 		description   string
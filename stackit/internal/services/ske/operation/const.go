@@ -0,0 +1,21 @@
+package ske
+
+import "fmt"
+
+// markdownDescription returns the example usage of the operation resource with the given type name
+func markdownDescription(typeName string) string {
+	return fmt.Sprintf(`
+## Example Usage`+"\n"+`
+
+### Trigger the operation again when the trigger value changes`+"\n"+
+		"```terraform"+`
+resource "stackit_ske_cluster_%s" "example" {
+  project_id   = "xxxxxxxx-xxxx-xxxx-xxxx-xxxxxxxxxxxx"
+  cluster_name = "example-name"
+
+  trigger_when_changed = {
+    trigger = "1"
+  }
+}
+`+"\n```", typeName)
+}
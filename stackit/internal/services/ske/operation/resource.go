@@ -0,0 +1,414 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/core/wait"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/validate"
+)
+
+const (
+	// operationTimeout is the maximum time to wait for the cluster to reach the target state of an operation
+	operationTimeout = 45 * time.Minute
+	// operationStartTimeout is the time after which a cluster that is still in a target state is considered done,
+	// in case the operation was processed before the first poll or had nothing to do
+	operationStartTimeout = 2 * time.Minute
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &operationResource{}
+	_ resource.ResourceWithConfigure  = &operationResource{}
+	_ resource.ResourceWithModifyPlan = &operationResource{}
+)
+
+type Model struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	ProjectId          types.String `tfsdk:"project_id"`
+	ClusterName        types.String `tfsdk:"cluster_name"`
+	Region             types.String `tfsdk:"region"`
+	TriggerWhenChanged types.Map    `tfsdk:"trigger_when_changed"`
+	Status             types.String `tfsdk:"status"`
+}
+
+type skeClient interface {
+	GetClusterExecute(ctx context.Context, projectId, region, clusterName string) (*ske.Cluster, error)
+}
+
+// operation describes a cluster operation that is triggered by creating the resource
+type operation struct {
+	// typeName is appended to "_ske_cluster_" to build the resource type name
+	typeName    string
+	description string
+	// subject is used in logs and error messages, e.g. "hibernation"
+	subject string
+	trigger func(ctx context.Context, c *ske.APIClient, projectId, region, clusterName string) error
+	// targetStates are the states in which the operation is done
+	targetStates []ske.ClusterStatusState
+	// startTimeout is the time after which a cluster in one of the target states is considered done, even if it wasn't seen in another state before
+	startTimeout time.Duration
+}
+
+var hibernateOperation = &operation{
+	typeName: "hibernate",
+	description: "SKE cluster hibernate resource schema. Creating the resource hibernates the cluster right away, independent of the scheduled `hibernations` of the cluster, " +
+		"and waits until the cluster is hibernated. The cluster wakes up again with the next scheduled wake-up. Deleting the resource doesn't affect the cluster.",
+	subject: "hibernation",
+	trigger: func(ctx context.Context, c *ske.APIClient, projectId, region, clusterName string) error {
+		_, err := c.TriggerHibernateExecute(ctx, projectId, region, clusterName)
+		return err
+	},
+	targetStates: []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_HIBERNATED},
+	startTimeout: operationStartTimeout,
+}
+
+var reconcileOperation = &operation{
+	typeName: "reconcile",
+	description: "SKE cluster reconcile resource schema. Creating the resource triggers a reconciliation of the cluster, e.g. after a failed maintenance, " +
+		"and waits until the cluster is healthy (or hibernated) again. Deleting the resource doesn't affect the cluster.",
+	subject: "reconciliation",
+	trigger: func(ctx context.Context, c *ske.APIClient, projectId, region, clusterName string) error {
+		_, err := c.TriggerReconcileExecute(ctx, projectId, region, clusterName)
+		return err
+	},
+	targetStates: []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_HEALTHY, ske.CLUSTERSTATUSSTATE_HIBERNATED},
+	startTimeout: operationStartTimeout,
+}
+
+var triggerMaintenanceOperation = &operation{
+	typeName: "trigger_maintenance",
+	description: "SKE cluster maintenance trigger resource schema. Creating the resource runs the maintenance of the cluster right away, outside of its maintenance window, " +
+		"and waits until the cluster is healthy (or hibernated) again. The updates applied depend on the `maintenance` settings of the cluster. Deleting the resource doesn't affect the cluster.",
+	subject: "maintenance",
+	trigger: func(ctx context.Context, c *ske.APIClient, projectId, region, clusterName string) error {
+		_, err := c.TriggerMaintenanceExecute(ctx, projectId, region, clusterName)
+		return err
+	},
+	targetStates: []ske.ClusterStatusState{ske.CLUSTERSTATUSSTATE_HEALTHY, ske.CLUSTERSTATUSSTATE_HIBERNATED},
+	startTimeout: operationStartTimeout,
+}
+
+// NewHibernateResource is a helper function to simplify the provider implementation.
+func NewHibernateResource() resource.Resource {
+	return &operationResource{operation: hibernateOperation}
+}
+
+// NewReconcileResource is a helper function to simplify the provider implementation.
+func NewReconcileResource() resource.Resource {
+	return &operationResource{operation: reconcileOperation}
+}
+
+// NewTriggerMaintenanceResource is a helper function to simplify the provider implementation.
+func NewTriggerMaintenanceResource() resource.Resource {
+	return &operationResource{operation: triggerMaintenanceOperation}
+}
+
+// operationResource is the resource implementation.
+type operationResource struct {
+	operation    *operation
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the resource type name.
+func (r *operationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_cluster_" + r.operation.typeName
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *operationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	var ok bool
+	r.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &r.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the resource.
+func (r *operationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	descriptions := map[string]string{
+		"main":                 r.operation.description,
+		"id":                   "Terraform's internal resource ID. It is structured as \"`project_id`,`region`,`cluster_name`\".",
+		"project_id":           "STACKIT project ID to which the cluster is associated.",
+		"cluster_name":         "Name of the SKE cluster.",
+		"region":               "The resource region. If not defined, the provider region is used.",
+		"trigger_when_changed": fmt.Sprintf("A map of arbitrary key/value pairs that triggers a new %s when changed. Modifying this map triggers the creation of a new resource.", r.operation.subject),
+		"status":               "The aggregated status of the cluster after the operation, e.g. `STATE_HEALTHY` or `STATE_HIBERNATED`.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description:         descriptions["main"],
+		MarkdownDescription: fmt.Sprintf("%s%s", descriptions["main"], markdownDescription(r.operation.typeName)),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: descriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.StringAttribute{
+				Description: descriptions["project_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.UUID(),
+					validate.NoSeparator(),
+				},
+			},
+			"cluster_name": schema.StringAttribute{
+				Description: descriptions["cluster_name"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validate.NoSeparator(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional: true,
+				// must be computed to allow for storing the override value from the provider
+				Computed:    true,
+				Description: descriptions["region"],
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"trigger_when_changed": schema.MapAttribute{
+				Description: descriptions["trigger_when_changed"],
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Description: descriptions["status"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan.
+// Use the modifier to set the effective region in the current plan.
+func (r *operationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
+	var configModel Model
+	// skip initial empty configuration to avoid follow-up errors
+	if req.Config.Raw.IsNull() {
+		return
+	}
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var planModel Model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	utils.AdaptRegion(ctx, configModel.Region, &planModel.Region, r.providerData.GetRegion(), resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Create triggers the operation, waits for the cluster to reach the target state and sets the initial Terraform state.
+func (r *operationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.Plan.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := model.Region.ValueString()
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	errorSummary := fmt.Sprintf("Error triggering cluster %s", r.operation.subject)
+	err := r.operation.trigger(ctx, r.client, projectId, region, clusterName)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, errorSummary, fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("SKE cluster %s triggered", r.operation.subject))
+
+	waitCtx := core.InitWaitProgress(ctx, "cluster")
+	cluster, err := operationWaitHandler(waitCtx, r.client, projectId, region, clusterName, r.operation).WaitWithContext(waitCtx)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, errorSummary, core.WaitErrorDetail(waitCtx, fmt.Sprintf("Cluster %s waiting: %v", r.operation.subject, err)))
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, errorSummary, fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("SKE cluster %s done", r.operation.subject))
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *operationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectId := model.ProjectId.ValueString()
+	clusterName := model.ClusterName.ValueString()
+	region := r.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "project_id", projectId)
+	ctx = tflog.SetField(ctx, "cluster_name", clusterName)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	cluster, err := r.client.GetClusterExecute(ctx, projectId, region, clusterName)
+	if err != nil {
+		utils.LogError(
+			ctx,
+			&resp.Diagnostics,
+			err,
+			fmt.Sprintf("Reading cluster %s", r.operation.subject),
+			fmt.Sprintf("Cluster with name %q does not exist in project %q.", clusterName, projectId),
+			map[int]string{
+				http.StatusForbidden: fmt.Sprintf("Project with ID %q not found or forbidden access", projectId),
+			},
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	err = mapFields(cluster, &model, region)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error reading cluster %s", r.operation.subject), fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("SKE cluster %s read", r.operation.subject))
+}
+
+// Update shouldn't be called, all attributes require a replacement
+func (r *operationResource) Update(ctx context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) { // nolint:gocritic // function signature required by Terraform
+	core.LogAndAddError(ctx, &resp.Diagnostics, fmt.Sprintf("Error updating cluster %s", r.operation.subject), fmt.Sprintf("Cluster %s can't be updated", r.operation.subject))
+}
+
+// Delete removes the resource from the Terraform state. The cluster is not affected.
+func (r *operationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) { // nolint:gocritic // function signature required by Terraform
+	var model Model
+	diags := req.State.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx = tflog.SetField(ctx, "project_id", model.ProjectId.ValueString())
+	ctx = tflog.SetField(ctx, "cluster_name", model.ClusterName.ValueString())
+	ctx = tflog.SetField(ctx, "region", model.Region.ValueString())
+
+	// the resource is removed from the state automatically
+	tflog.Info(ctx, fmt.Sprintf("SKE cluster %s deleted", r.operation.subject))
+}
+
+// operationWaitHandler waits for the cluster to reach one of the target states of the operation. As the cluster may
+// still be in a target state right after triggering the operation, a target state only finishes the wait once the
+// cluster was seen in another state, or after the start timeout of the operation. An unhealthy cluster fails the
+// operation under the same condition.
+func operationWaitHandler(ctx context.Context, a skeClient, projectId, region, clusterName string, op *operation) *wait.AsyncActionHandler[ske.Cluster] {
+	started := false
+	startDeadline := time.Now().Add(op.startTimeout)
+	handler := wait.New(func() (waitFinished bool, response *ske.Cluster, err error) {
+		cluster, err := a.GetClusterExecute(ctx, projectId, region, clusterName)
+		if err != nil {
+			return false, nil, err
+		}
+		if cluster.Status == nil || cluster.Status.Aggregated == nil {
+			return false, nil, nil
+		}
+		state := *cluster.Status.Aggregated
+
+		if slices.Contains(op.targetStates, state) {
+			if started || time.Now().After(startDeadline) {
+				return true, cluster, nil
+			}
+			return false, nil, nil
+		}
+		if state == ske.CLUSTERSTATUSSTATE_DELETING {
+			return true, cluster, fmt.Errorf("cluster is being deleted")
+		}
+		// the cluster may have been unhealthy before the operation, e.g. when triggering a reconciliation to fix it
+		if state == ske.CLUSTERSTATUSSTATE_UNHEALTHY {
+			if started || time.Now().After(startDeadline) {
+				return true, cluster, fmt.Errorf("cluster is unhealthy")
+			}
+			return false, nil, nil
+		}
+		started = true
+		return false, nil, nil
+	})
+	handler.SetTimeout(operationTimeout)
+	return handler
+}
+
+func mapFields(cluster *ske.Cluster, model *Model, region string) error {
+	if cluster == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	model.Id = utils.BuildInternalTerraformId(model.ProjectId.ValueString(), region, model.ClusterName.ValueString())
+	model.Region = types.StringValue(region)
+
+	model.Status = types.StringNull()
+	if cluster.Status != nil && cluster.Status.Aggregated != nil {
+		model.Status = types.StringValue(string(*cluster.Status.Aggregated))
+	}
+	return nil
+}
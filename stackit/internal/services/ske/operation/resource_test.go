@@ -0,0 +1,256 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+)
+
+type skeClientMocked struct {
+	states      []ske.ClusterStatusState
+	calls       int
+	returnError bool
+}
+
+func (c *skeClientMocked) GetClusterExecute(_ context.Context, _, _, _ string) (*ske.Cluster, error) {
+	if c.returnError {
+		return nil, fmt.Errorf("get cluster failed")
+	}
+	state := c.states[min(c.calls, len(c.states)-1)]
+	c.calls++
+	return &ske.Cluster{
+		Status: &ske.ClusterStatus{
+			Aggregated: state.Ptr(),
+		},
+	}, nil
+}
+
+func TestMapFields(t *testing.T) {
+	tests := []struct {
+		description string
+		input       *ske.Cluster
+		expected    Model
+		isValid     bool
+	}{
+		{
+			"default_values",
+			&ske.Cluster{},
+			Model{
+				Id:                 types.StringValue("pid,eu01,name"),
+				ProjectId:          types.StringValue("pid"),
+				ClusterName:        types.StringValue("name"),
+				Region:             types.StringValue("eu01"),
+				TriggerWhenChanged: types.MapNull(types.StringType),
+				Status:             types.StringNull(),
+			},
+			true,
+		},
+		{
+			"hibernated",
+			&ske.Cluster{
+				Status: &ske.ClusterStatus{
+					Aggregated: ske.CLUSTERSTATUSSTATE_HIBERNATED.Ptr(),
+				},
+			},
+			Model{
+				Id:                 types.StringValue("pid,eu01,name"),
+				ProjectId:          types.StringValue("pid"),
+				ClusterName:        types.StringValue("name"),
+				Region:             types.StringValue("eu01"),
+				TriggerWhenChanged: types.MapNull(types.StringType),
+				Status:             types.StringValue("STATE_HIBERNATED"),
+			},
+			true,
+		},
+		{
+			"nil_response",
+			nil,
+			Model{},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			model := &Model{
+				ProjectId:          tt.expected.ProjectId,
+				ClusterName:        tt.expected.ClusterName,
+				TriggerWhenChanged: types.MapNull(types.StringType),
+			}
+			err := mapFields(tt.input, model, "eu01")
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				diff := cmp.Diff(model, &tt.expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestOperationWaitHandler(t *testing.T) {
+	tests := []struct {
+		description   string
+		operation     *operation
+		states        []ske.ClusterStatusState
+		startTimeout  time.Duration
+		getFails      bool
+		expectedState ske.ClusterStatusState
+		expectedCalls int
+		isValid       bool
+	}{
+		{
+			"hibernate",
+			hibernateOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+				ske.CLUSTERSTATUSSTATE_HIBERNATING,
+				ske.CLUSTERSTATUSSTATE_HIBERNATED,
+			},
+			time.Hour,
+			false,
+			ske.CLUSTERSTATUSSTATE_HIBERNATED,
+			3,
+			true,
+		},
+		{
+			"reconcile_waits_for_reconciliation_to_start",
+			reconcileOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+				ske.CLUSTERSTATUSSTATE_RECONCILING,
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+			},
+			time.Hour,
+			false,
+			ske.CLUSTERSTATUSSTATE_HEALTHY,
+			4,
+			true,
+		},
+		{
+			"reconcile_without_state_change",
+			reconcileOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+			},
+			0,
+			false,
+			ske.CLUSTERSTATUSSTATE_HEALTHY,
+			1,
+			true,
+		},
+		{
+			"maintenance_of_hibernated_cluster",
+			triggerMaintenanceOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_RECONCILING,
+				ske.CLUSTERSTATUSSTATE_HIBERNATED,
+			},
+			time.Hour,
+			false,
+			ske.CLUSTERSTATUSSTATE_HIBERNATED,
+			2,
+			true,
+		},
+		{
+			"cluster_deleting",
+			hibernateOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_DELETING,
+			},
+			time.Hour,
+			false,
+			"",
+			1,
+			false,
+		},
+		{
+			"unhealthy_after_reconciliation",
+			reconcileOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+				ske.CLUSTERSTATUSSTATE_RECONCILING,
+				ske.CLUSTERSTATUSSTATE_UNHEALTHY,
+			},
+			time.Hour,
+			false,
+			"",
+			3,
+			false,
+		},
+		{
+			"reconcile_unhealthy_cluster",
+			reconcileOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_UNHEALTHY,
+				ske.CLUSTERSTATUSSTATE_RECONCILING,
+				ske.CLUSTERSTATUSSTATE_HEALTHY,
+			},
+			time.Hour,
+			false,
+			ske.CLUSTERSTATUSSTATE_HEALTHY,
+			3,
+			true,
+		},
+		{
+			"unhealthy_without_state_change",
+			reconcileOperation,
+			[]ske.ClusterStatusState{
+				ske.CLUSTERSTATUSSTATE_UNHEALTHY,
+			},
+			0,
+			false,
+			"",
+			1,
+			false,
+		},
+		{
+			"get_fails",
+			hibernateOperation,
+			nil,
+			time.Hour,
+			true,
+			"",
+			0,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			client := &skeClientMocked{
+				states:      tt.states,
+				returnError: tt.getFails,
+			}
+			op := *tt.operation
+			op.startTimeout = tt.startTimeout
+			handler := operationWaitHandler(context.Background(), client, "pid", "eu01", "name", &op)
+			handler.SetThrottle(time.Millisecond).SetTempErrRetryLimit(0).SetTimeout(time.Second)
+			cluster, err := handler.WaitWithContext(context.Background())
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				if state := cluster.Status.GetAggregated(); state != tt.expectedState {
+					t.Fatalf("expected state %q, got %q", tt.expectedState, state)
+				}
+				if client.calls != tt.expectedCalls {
+					t.Fatalf("expected %d calls, got %d", tt.expectedCalls, client.calls)
+				}
+			}
+		})
+	}
+}
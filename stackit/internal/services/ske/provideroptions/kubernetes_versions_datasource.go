@@ -0,0 +1,217 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &kubernetesVersionsDataSource{}
+)
+
+type KubernetesVersionsModel struct {
+	Id                 types.String `tfsdk:"id"` // needed by TF
+	Region             types.String `tfsdk:"region"`
+	VersionState       types.String `tfsdk:"version_state"`
+	VersionMin         types.String `tfsdk:"version_min"`
+	KubernetesVersions types.List   `tfsdk:"kubernetes_versions"`
+}
+
+// Types corresponding to KubernetesVersionsModel.KubernetesVersions[i]
+var kubernetesVersionTypes = map[string]attr.Type{
+	"version":         types.StringType,
+	"state":           types.StringType,
+	"expiration_date": types.StringType,
+	"feature_gates":   types.MapType{ElemType: types.StringType},
+}
+
+// NewKubernetesVersionsDataSource is a helper function to simplify the provider implementation.
+func NewKubernetesVersionsDataSource() datasource.DataSource {
+	return &kubernetesVersionsDataSource{}
+}
+
+// kubernetesVersionsDataSource is the data source implementation.
+type kubernetesVersionsDataSource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *kubernetesVersionsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_kubernetes_versions"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *kubernetesVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *kubernetesVersionsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Kubernetes versions offered by SKE, sorted from the latest to the oldest version."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`region`\".",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+			},
+			"version_state": schema.StringAttribute{
+				Description: "Only list versions in this state. " + utils.FormatPossibleValues(versionStates...),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(versionStates...),
+				},
+			},
+			"version_min": schema.StringAttribute{
+				Description: "Only list versions greater than or equal to this version, e.g. `1.30` or `1.30.5`.",
+				Optional:    true,
+			},
+			"kubernetes_versions": schema.ListNestedAttribute{
+				Description: "The Kubernetes versions matching the filters.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							Description: "The Kubernetes version.",
+							Computed:    true,
+						},
+						"state": schema.StringAttribute{
+							Description: "The state of the version. " + utils.FormatPossibleValues(versionStates...),
+							Computed:    true,
+						},
+						"expiration_date": schema.StringAttribute{
+							Description: "Date (RFC3339 format) after which the version is no longer offered. Null if no expiration is scheduled.",
+							Computed:    true,
+						},
+						"feature_gates": schema.MapAttribute{
+							Description: "Feature gates of the version.",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *kubernetesVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model KubernetesVersionsModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	filter, err := newVersionFilter(model.VersionState, model.VersionMin)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Kubernetes versions", fmt.Sprintf("Invalid filter: %v", err))
+		return
+	}
+
+	providerOptions, err := d.client.ListProviderOptions(ctx, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Kubernetes versions", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapKubernetesVersions(ctx, providerOptions, &model, region, filter)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading Kubernetes versions", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE Kubernetes versions read")
+}
+
+func mapKubernetesVersions(ctx context.Context, providerOptions *ske.ProviderOptions, model *KubernetesVersionsModel, region string, filter *versionFilter) error {
+	if providerOptions == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	versions := []ske.KubernetesVersion{}
+	for _, v := range providerOptions.GetKubernetesVersions() {
+		if filter.matches(v.Version, v.State) {
+			versions = append(versions, v)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return isNewerVersion(versions[i].Version, versions[j].Version)
+	})
+
+	versionsList := []attr.Value{}
+	for i := range versions {
+		v := &versions[i]
+		featureGates := types.MapNull(types.StringType)
+		if v.FeatureGates != nil {
+			var diags diag.Diagnostics
+			featureGates, diags = types.MapValueFrom(ctx, types.StringType, *v.FeatureGates)
+			if diags.HasError() {
+				return fmt.Errorf("mapping feature gates of Kubernetes version %q: %w", v.GetVersion(), core.DiagsToError(diags))
+			}
+		}
+		versionObject, diags := types.ObjectValue(kubernetesVersionTypes, map[string]attr.Value{
+			"version":         types.StringPointerValue(v.Version),
+			"state":           types.StringPointerValue(v.State),
+			"expiration_date": expirationDateValue(v.ExpirationDate),
+			"feature_gates":   featureGates,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping Kubernetes version %q: %w", v.GetVersion(), core.DiagsToError(diags))
+		}
+		versionsList = append(versionsList, versionObject)
+	}
+	versionsTF, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: kubernetesVersionTypes}, versionsList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	model.Id = types.StringValue(region)
+	model.Region = types.StringValue(region)
+	model.KubernetesVersions = versionsTF
+	return nil
+}
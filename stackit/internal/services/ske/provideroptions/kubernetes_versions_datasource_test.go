@@ -0,0 +1,184 @@
+package ske
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+)
+
+func TestMapKubernetesVersions(t *testing.T) {
+	expirationDate := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	providerOptions := &ske.ProviderOptions{
+		KubernetesVersions: &[]ske.KubernetesVersion{
+			{
+				Version:        utils.Ptr("1.30.10"),
+				State:          utils.Ptr(skeUtils.VersionStateDeprecated),
+				ExpirationDate: &expirationDate,
+			},
+			{
+				Version:      utils.Ptr("1.31.6"),
+				State:        utils.Ptr(skeUtils.VersionStateSupported),
+				FeatureGates: &map[string]string{"SomeGate": "1.31"},
+			},
+			{
+				Version: utils.Ptr("1.32.2"),
+				State:   utils.Ptr(skeUtils.VersionStatePreview),
+			},
+			{
+				Version: utils.Ptr("1.31.10"),
+				State:   utils.Ptr(skeUtils.VersionStateSupported),
+			},
+		},
+	}
+	versionObject := func(version, state string, expiration types.String, featureGates types.Map) attr.Value {
+		return types.ObjectValueMust(kubernetesVersionTypes, map[string]attr.Value{
+			"version":         types.StringValue(version),
+			"state":           types.StringValue(state),
+			"expiration_date": expiration,
+			"feature_gates":   featureGates,
+		})
+	}
+	tests := []struct {
+		description  string
+		input        *ske.ProviderOptions
+		versionState types.String
+		versionMin   types.String
+		expected     []attr.Value
+		isValid      bool
+	}{
+		{
+			"all versions sorted",
+			providerOptions,
+			types.StringNull(),
+			types.StringNull(),
+			[]attr.Value{
+				versionObject("1.32.2", skeUtils.VersionStatePreview, types.StringNull(), types.MapNull(types.StringType)),
+				versionObject("1.31.10", skeUtils.VersionStateSupported, types.StringNull(), types.MapNull(types.StringType)),
+				versionObject("1.31.6", skeUtils.VersionStateSupported, types.StringNull(), types.MapValueMust(types.StringType, map[string]attr.Value{
+					"SomeGate": types.StringValue("1.31"),
+				})),
+				versionObject("1.30.10", skeUtils.VersionStateDeprecated, types.StringValue("2025-01-31T00:00:00Z"), types.MapNull(types.StringType)),
+			},
+			true,
+		},
+		{
+			"filter by state and minimum version",
+			providerOptions,
+			types.StringValue(skeUtils.VersionStateSupported),
+			types.StringValue("1.31.7"),
+			[]attr.Value{
+				versionObject("1.31.10", skeUtils.VersionStateSupported, types.StringNull(), types.MapNull(types.StringType)),
+			},
+			true,
+		},
+		{
+			"filter by minor version",
+			providerOptions,
+			types.StringNull(),
+			types.StringValue("1.31"),
+			[]attr.Value{
+				versionObject("1.32.2", skeUtils.VersionStatePreview, types.StringNull(), types.MapNull(types.StringType)),
+				versionObject("1.31.10", skeUtils.VersionStateSupported, types.StringNull(), types.MapNull(types.StringType)),
+				versionObject("1.31.6", skeUtils.VersionStateSupported, types.StringNull(), types.MapValueMust(types.StringType, map[string]attr.Value{
+					"SomeGate": types.StringValue("1.31"),
+				})),
+			},
+			true,
+		},
+		{
+			"empty response",
+			&ske.ProviderOptions{},
+			types.StringNull(),
+			types.StringNull(),
+			[]attr.Value{},
+			true,
+		},
+		{
+			"nil response",
+			nil,
+			types.StringNull(),
+			types.StringNull(),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			filter, err := newVersionFilter(tt.versionState, tt.versionMin)
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			model := &KubernetesVersionsModel{
+				VersionState: tt.versionState,
+				VersionMin:   tt.versionMin,
+			}
+			err = mapKubernetesVersions(context.Background(), tt.input, model, "eu01", filter)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				expected := &KubernetesVersionsModel{
+					Id:                 types.StringValue("eu01"),
+					Region:             types.StringValue("eu01"),
+					VersionState:       tt.versionState,
+					VersionMin:         tt.versionMin,
+					KubernetesVersions: types.ListValueMust(types.ObjectType{AttrTypes: kubernetesVersionTypes}, tt.expected),
+				}
+				diff := cmp.Diff(model, expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestNewVersionFilter(t *testing.T) {
+	tests := []struct {
+		description string
+		versionMin  types.String
+		isValid     bool
+	}{
+		{
+			"no minimum version",
+			types.StringNull(),
+			true,
+		},
+		{
+			"minor version",
+			types.StringValue("1.31"),
+			true,
+		},
+		{
+			"patch version",
+			types.StringValue("1.31.2"),
+			true,
+		},
+		{
+			"invalid version",
+			types.StringValue("latest"),
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			_, err := newVersionFilter(types.StringNull(), tt.versionMin)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package ske
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/conversion"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource = &machineImagesDataSource{}
+)
+
+type MachineImagesModel struct {
+	Id            types.String `tfsdk:"id"` // needed by TF
+	Region        types.String `tfsdk:"region"`
+	Name          types.String `tfsdk:"name"`
+	VersionState  types.String `tfsdk:"version_state"`
+	VersionMin    types.String `tfsdk:"version_min"`
+	MachineImages types.List   `tfsdk:"machine_images"`
+}
+
+// Types corresponding to MachineImagesModel.MachineImages[i]
+var machineImageTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"versions": types.ListType{ElemType: types.ObjectType{AttrTypes: machineImageVersionTypes}},
+}
+
+// Types corresponding to MachineImagesModel.MachineImages[i].Versions[j]
+var machineImageVersionTypes = map[string]attr.Type{
+	"version":         types.StringType,
+	"state":           types.StringType,
+	"expiration_date": types.StringType,
+	"cri":             types.ListType{ElemType: types.StringType},
+}
+
+// NewMachineImagesDataSource is a helper function to simplify the provider implementation.
+func NewMachineImagesDataSource() datasource.DataSource {
+	return &machineImagesDataSource{}
+}
+
+// machineImagesDataSource is the data source implementation.
+type machineImagesDataSource struct {
+	client       *ske.APIClient
+	providerData core.ProviderData
+}
+
+// Metadata returns the data source type name.
+func (d *machineImagesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ske_machine_images"
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *machineImagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	var ok bool
+	d.providerData, ok = conversion.ParseProviderData(ctx, req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	apiClient := skeUtils.ConfigureClient(ctx, &d.providerData, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = apiClient
+	tflog.Info(ctx, "SKE client configured")
+}
+
+// Schema defines the schema for the data source.
+func (d *machineImagesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	description := "Machine images offered for SKE node pools. The versions of each image are sorted from the latest to the oldest version."
+	resp.Schema = schema.Schema{
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Terraform's internal data source ID. It is structured as \"`region`\".",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "The resource region. If not defined, the provider region is used.",
+				Optional:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Only list the machine image with this name, e.g. `flatcar` or `ubuntu`.",
+				Optional:    true,
+			},
+			"version_state": schema.StringAttribute{
+				Description: "Only list image versions in this state. " + utils.FormatPossibleValues(versionStates...),
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(versionStates...),
+				},
+			},
+			"version_min": schema.StringAttribute{
+				Description: "Only list image versions greater than or equal to this version, e.g. `3815.2`.",
+				Optional:    true,
+			},
+			"machine_images": schema.ListNestedAttribute{
+				Description: "The machine images matching the filters. Images without matching versions are omitted.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "The name of the machine image.",
+							Computed:    true,
+						},
+						"versions": schema.ListNestedAttribute{
+							Description: "The versions of the machine image.",
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"version": schema.StringAttribute{
+										Description: "The version of the machine image.",
+										Computed:    true,
+									},
+									"state": schema.StringAttribute{
+										Description: "The state of the version. " + utils.FormatPossibleValues(versionStates...),
+										Computed:    true,
+									},
+									"expiration_date": schema.StringAttribute{
+										Description: "Date (RFC3339 format) after which the version is no longer offered. Null if no expiration is scheduled.",
+										Computed:    true,
+									},
+									"cri": schema.ListAttribute{
+										Description: "The container runtimes supported by the version.",
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *machineImagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) { // nolint:gocritic // function signature required by Terraform
+	var model MachineImagesModel
+	diags := req.Config.Get(ctx, &model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	region := d.providerData.GetRegionWithOverride(model.Region)
+	ctx = tflog.SetField(ctx, "region", region)
+
+	filter, err := newVersionFilter(model.VersionState, model.VersionMin)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine images", fmt.Sprintf("Invalid filter: %v", err))
+		return
+	}
+
+	providerOptions, err := d.client.ListProviderOptions(ctx, region).Execute()
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine images", fmt.Sprintf("Calling API: %v", err))
+		return
+	}
+
+	err = mapMachineImages(ctx, providerOptions, &model, region, filter)
+	if err != nil {
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error reading machine images", fmt.Sprintf("Processing API payload: %v", err))
+		return
+	}
+	diags = resp.State.Set(ctx, model)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	tflog.Info(ctx, "SKE machine images read")
+}
+
+func mapMachineImages(ctx context.Context, providerOptions *ske.ProviderOptions, model *MachineImagesModel, region string, filter *versionFilter) error {
+	if providerOptions == nil {
+		return fmt.Errorf("response input is nil")
+	}
+	if model == nil {
+		return fmt.Errorf("model input is nil")
+	}
+
+	imagesList := []attr.Value{}
+	for _, image := range providerOptions.GetMachineImages() {
+		if !model.Name.IsNull() && !strings.EqualFold(image.GetName(), model.Name.ValueString()) {
+			continue
+		}
+
+		versions := []ske.MachineImageVersion{}
+		for _, v := range image.GetVersions() {
+			if filter.matches(v.Version, v.State) {
+				versions = append(versions, v)
+			}
+		}
+		if len(versions) == 0 {
+			continue
+		}
+		sort.SliceStable(versions, func(i, j int) bool {
+			return isNewerVersion(versions[i].Version, versions[j].Version)
+		})
+
+		versionsList := []attr.Value{}
+		for i := range versions {
+			v := &versions[i]
+			cri := []attr.Value{}
+			for _, c := range v.GetCri() {
+				cri = append(cri, types.StringValue(string(c.GetName())))
+			}
+			criTF, diags := types.ListValue(types.StringType, cri)
+			if diags.HasError() {
+				return fmt.Errorf("mapping CRI of machine image %q version %q: %w", image.GetName(), v.GetVersion(), core.DiagsToError(diags))
+			}
+			versionObject, diags := types.ObjectValue(machineImageVersionTypes, map[string]attr.Value{
+				"version":         types.StringPointerValue(v.Version),
+				"state":           types.StringPointerValue(v.State),
+				"expiration_date": expirationDateValue(v.ExpirationDate),
+				"cri":             criTF,
+			})
+			if diags.HasError() {
+				return fmt.Errorf("mapping machine image %q version %q: %w", image.GetName(), v.GetVersion(), core.DiagsToError(diags))
+			}
+			versionsList = append(versionsList, versionObject)
+		}
+		versionsTF, diags := types.ListValue(types.ObjectType{AttrTypes: machineImageVersionTypes}, versionsList)
+		if diags.HasError() {
+			return fmt.Errorf("mapping versions of machine image %q: %w", image.GetName(), core.DiagsToError(diags))
+		}
+
+		imageObject, diags := types.ObjectValue(machineImageTypes, map[string]attr.Value{
+			"name":     types.StringPointerValue(image.Name),
+			"versions": versionsTF,
+		})
+		if diags.HasError() {
+			return fmt.Errorf("mapping machine image %q: %w", image.GetName(), core.DiagsToError(diags))
+		}
+		imagesList = append(imagesList, imageObject)
+	}
+	imagesTF, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: machineImageTypes}, imagesList)
+	if diags.HasError() {
+		return core.DiagsToError(diags)
+	}
+
+	model.Id = types.StringValue(region)
+	model.Region = types.StringValue(region)
+	model.MachineImages = imagesTF
+	return nil
+}
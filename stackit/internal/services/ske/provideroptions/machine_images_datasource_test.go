@@ -0,0 +1,146 @@
+package ske
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stackitcloud/stackit-sdk-go/core/utils"
+	"github.com/stackitcloud/stackit-sdk-go/services/ske"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+)
+
+func TestMapMachineImages(t *testing.T) {
+	providerOptions := &ske.ProviderOptions{
+		MachineImages: &[]ske.MachineImage{
+			{
+				Name: utils.Ptr("flatcar"),
+				Versions: &[]ske.MachineImageVersion{
+					{
+						Version: utils.Ptr("3815.2.5"),
+						State:   utils.Ptr(skeUtils.VersionStateSupported),
+						Cri:     &[]ske.CRI{{Name: ske.CRINAME_CONTAINERD.Ptr()}},
+					},
+					{
+						Version: utils.Ptr("4081.2.1"),
+						State:   utils.Ptr(skeUtils.VersionStatePreview),
+					},
+				},
+			},
+			{
+				Name: utils.Ptr("ubuntu"),
+				Versions: &[]ske.MachineImageVersion{
+					{
+						Version: utils.Ptr("2204.20240912.0"),
+						State:   utils.Ptr(skeUtils.VersionStateSupported),
+					},
+				},
+			},
+		},
+	}
+	versionObject := func(version, state string, cri []attr.Value) attr.Value {
+		return types.ObjectValueMust(machineImageVersionTypes, map[string]attr.Value{
+			"version":         types.StringValue(version),
+			"state":           types.StringValue(state),
+			"expiration_date": types.StringNull(),
+			"cri":             types.ListValueMust(types.StringType, cri),
+		})
+	}
+	imageObject := func(name string, versions ...attr.Value) attr.Value {
+		return types.ObjectValueMust(machineImageTypes, map[string]attr.Value{
+			"name":     types.StringValue(name),
+			"versions": types.ListValueMust(types.ObjectType{AttrTypes: machineImageVersionTypes}, versions),
+		})
+	}
+	tests := []struct {
+		description  string
+		input        *ske.ProviderOptions
+		name         types.String
+		versionState types.String
+		expected     []attr.Value
+		isValid      bool
+	}{
+		{
+			"all images",
+			providerOptions,
+			types.StringNull(),
+			types.StringNull(),
+			[]attr.Value{
+				imageObject("flatcar",
+					versionObject("4081.2.1", skeUtils.VersionStatePreview, []attr.Value{}),
+					versionObject("3815.2.5", skeUtils.VersionStateSupported, []attr.Value{types.StringValue("containerd")}),
+				),
+				imageObject("ubuntu",
+					versionObject("2204.20240912.0", skeUtils.VersionStateSupported, []attr.Value{}),
+				),
+			},
+			true,
+		},
+		{
+			"filter by name and state",
+			providerOptions,
+			types.StringValue("flatcar"),
+			types.StringValue(skeUtils.VersionStateSupported),
+			[]attr.Value{
+				imageObject("flatcar",
+					versionObject("3815.2.5", skeUtils.VersionStateSupported, []attr.Value{types.StringValue("containerd")}),
+				),
+			},
+			true,
+		},
+		{
+			"images without matching versions are omitted",
+			providerOptions,
+			types.StringNull(),
+			types.StringValue(skeUtils.VersionStatePreview),
+			[]attr.Value{
+				imageObject("flatcar",
+					versionObject("4081.2.1", skeUtils.VersionStatePreview, []attr.Value{}),
+				),
+			},
+			true,
+		},
+		{
+			"nil response",
+			nil,
+			types.StringNull(),
+			types.StringNull(),
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			filter, err := newVersionFilter(tt.versionState, types.StringNull())
+			if err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			model := &MachineImagesModel{
+				Name:         tt.name,
+				VersionState: tt.versionState,
+			}
+			err = mapMachineImages(context.Background(), tt.input, model, "eu01", filter)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if tt.isValid {
+				expected := &MachineImagesModel{
+					Id:            types.StringValue("eu01"),
+					Region:        types.StringValue("eu01"),
+					Name:          tt.name,
+					VersionState:  tt.versionState,
+					MachineImages: types.ListValueMust(types.ObjectType{AttrTypes: machineImageTypes}, tt.expected),
+				}
+				diff := cmp.Diff(model, expected)
+				if diff != "" {
+					t.Fatalf("Data does not match: %s", diff)
+				}
+			}
+		})
+	}
+}
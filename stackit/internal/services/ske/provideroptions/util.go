@@ -0,0 +1,66 @@
+package ske
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	skeUtils "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/utils"
+	"golang.org/x/mod/semver"
+)
+
+var versionStates = []string{skeUtils.VersionStateSupported, skeUtils.VersionStatePreview, skeUtils.VersionStateDeprecated}
+
+// versionFilter selects versions by state and by a minimum version
+type versionFilter struct {
+	state      string
+	minVersion string
+}
+
+func newVersionFilter(state, minVersion types.String) (*versionFilter, error) {
+	f := &versionFilter{
+		state:      state.ValueString(),
+		minVersion: minVersion.ValueString(),
+	}
+	if f.minVersion != "" && !semver.IsValid(canonicalVersion(f.minVersion)) {
+		return nil, fmt.Errorf("invalid minimum version %q", f.minVersion)
+	}
+	return f, nil
+}
+
+func (f *versionFilter) matches(version, state *string) bool {
+	if version == nil {
+		return false
+	}
+	if f.state != "" && (state == nil || !strings.EqualFold(*state, f.state)) {
+		return false
+	}
+	if f.minVersion != "" && semver.Compare(canonicalVersion(*version), canonicalVersion(f.minVersion)) < 0 {
+		return false
+	}
+	return true
+}
+
+// canonicalVersion prefixes the version with "v", as expected by the semver package
+func canonicalVersion(version string) string {
+	if !strings.HasPrefix(version, "v") {
+		return "v" + version
+	}
+	return version
+}
+
+// isNewerVersion is used to sort versions so that the latest version comes first
+func isNewerVersion(v1, v2 *string) bool {
+	if v1 == nil || v2 == nil {
+		return v2 == nil && v1 != nil
+	}
+	return semver.Compare(canonicalVersion(*v1), canonicalVersion(*v2)) > 0
+}
+
+func expirationDateValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
+}
@@ -3,6 +3,7 @@ package utils
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/stackitcloud/stackit-sdk-go/core/config"
@@ -11,6 +12,13 @@ import (
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 )
 
+// States of the Kubernetes and machine image versions offered by SKE
+const (
+	VersionStateSupported  = "supported"
+	VersionStatePreview    = "preview"
+	VersionStateDeprecated = "deprecated"
+)
+
 func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags *diag.Diagnostics) *ske.APIClient {
 	apiClientConfigOptions := []config.ConfigurationOption{
 		config.WithCustomAuth(providerData.RoundTripper),
@@ -27,3 +35,20 @@ func ConfigureClient(ctx context.Context, providerData *core.ProviderData, diags
 
 	return apiClient
 }
+
+// clusterLocks holds a mutex per cluster, see LockCluster
+var clusterLocks sync.Map
+
+// LockCluster serializes changes to the spec of a cluster within the provider process, as the cluster and its node pools
+// are updated by sending the whole cluster spec. The returned function releases the lock.
+func LockCluster(projectId, region, name string) (unlock func()) {
+	key := utils.BuildInternalTerraformId(projectId, region, name).ValueString()
+	mu, _ := clusterLocks.LoadOrStore(key, &sync.Mutex{})
+	lock, ok := mu.(*sync.Mutex)
+	if !ok {
+		// clusterLocks only holds mutexes
+		return func() {}
+	}
+	lock.Lock()
+	return lock.Unlock
+}
@@ -45,6 +45,12 @@ var (
 	_ resource.ResourceWithModifyPlan  = &instanceResource{}
 )
 
+// Caches of the catalog endpoints used for the plan-time validation
+var (
+	flavorsCache  utils.CatalogCache[[]utils.FlavorSpec]
+	storagesCache utils.CatalogCache[*utils.StorageOptions]
+)
+
 type Model struct {
 	Id             types.String `tfsdk:"id"` // needed by TF
 	InstanceId     types.String `tfsdk:"instance_id"`
@@ -111,6 +117,72 @@ type instanceResource struct {
 	providerData core.ProviderData
 }
 
+// validateFlavorAndStorage validates the configured flavor and storage against the flavors and
+// storage options offered by SQLServer Flex. state is nil on create, see utils.ValidateFlavorStorage.
+func (r *instanceResource) validateFlavorAndStorage(ctx context.Context, diags *diag.Diagnostics, model, state *Model) {
+	if utils.IsUndefined(model.ProjectId) || utils.IsUndefined(model.Region) {
+		return
+	}
+	flavorStorage := toFlavorStorage(ctx, diags, model)
+	var stateFlavorStorage *utils.FlavorStorage
+	if state != nil {
+		stateValue := toFlavorStorage(ctx, diags, state)
+		stateFlavorStorage = &stateValue
+	}
+	if diags.HasError() {
+		return
+	}
+
+	projectId := model.ProjectId.ValueString()
+	region := model.Region.ValueString()
+	loadFlavors := func() ([]utils.FlavorSpec, error) {
+		return flavorsCache.Get(projectId+core.Separator+region, func() ([]utils.FlavorSpec, error) {
+			res, err := r.client.ListFlavors(ctx, projectId, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			specs := []utils.FlavorSpec{}
+			for _, f := range res.GetFlavors() {
+				specs = append(specs, utils.FlavorSpec{Id: f.GetId(), CPU: f.GetCpu(), RAM: f.GetMemory()})
+			}
+			return specs, nil
+		})
+	}
+	loadStorageOptions := func(flavorId string) (*utils.StorageOptions, error) {
+		return storagesCache.Get(projectId+core.Separator+region+core.Separator+flavorId, func() (*utils.StorageOptions, error) {
+			res, err := r.client.ListStorages(ctx, projectId, flavorId, region).Execute()
+			if err != nil {
+				return nil, err
+			}
+			options := &utils.StorageOptions{Classes: res.GetStorageClasses()}
+			if storageRange, ok := res.GetStorageRangeOk(); ok {
+				options.MinSize = storageRange.Min
+				options.MaxSize = storageRange.Max
+			}
+			return options, nil
+		})
+	}
+	utils.ValidateFlavorStorage(ctx, diags, "SQLServer Flex", flavorStorage, stateFlavorStorage, loadFlavors, loadStorageOptions)
+}
+
+// toFlavorStorage returns the flavor and storage of the model. Values of unset objects are null.
+func toFlavorStorage(ctx context.Context, diags *diag.Diagnostics, model *Model) utils.FlavorStorage {
+	flavor := &flavorModel{}
+	if !utils.IsUndefined(model.Flavor) {
+		diags.Append(model.Flavor.As(ctx, flavor, basetypes.ObjectAsOptions{})...)
+	}
+	storage := &storageModel{}
+	if !utils.IsUndefined(model.Storage) {
+		diags.Append(model.Storage.As(ctx, storage, basetypes.ObjectAsOptions{})...)
+	}
+	return utils.FlavorStorage{
+		CPU:          flavor.CPU,
+		RAM:          flavor.RAM,
+		StorageClass: storage.Class,
+		StorageSize:  storage.Size,
+	}
+}
+
 // Metadata returns the resource type name.
 func (r *instanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_sqlserverflex_instance"
@@ -133,7 +205,7 @@ func (r *instanceResource) Configure(ctx context.Context, req resource.Configure
 }
 
 // ModifyPlan implements resource.ResourceWithModifyPlan.
-// Use the modifier to set the effective region in the current plan.
+// Use the modifier to set the effective region in the current plan and to validate the flavor and storage.
 func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) { // nolint:gocritic // function signature required by Terraform
 	var configModel Model
 	// skip initial empty configuration to avoid follow-up errors
@@ -156,6 +228,21 @@ func (r *instanceResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	if !req.Plan.Raw.IsNull() {
+		var stateModel *Model
+		if !req.State.Raw.IsNull() {
+			stateModel = &Model{}
+			resp.Diagnostics.Append(req.State.Get(ctx, stateModel)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		r.validateFlavorAndStorage(ctx, &resp.Diagnostics, &planModel, stateModel)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, planModel)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -405,9 +492,10 @@ func (r *instanceResource) Create(ctx context.Context, req resource.CreateReques
 	ctx = tflog.SetField(ctx, "instance_id", instanceId)
 	// The creation waiter sometimes returns an error from the API: "instance with id xxx has unexpected status Failure"
 	// which can be avoided by sleeping before wait
-	waitResp, err := wait.CreateInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).SetSleepBeforeWait(30 * time.Second).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.CreateInstanceWaitHandler(waitCtx, r.client, projectId, instanceId, region).SetSleepBeforeWait(30 * time.Second).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", fmt.Sprintf("Instance creation waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error creating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance creation waiting: %v", err)))
 		return
 	}
 
@@ -567,9 +655,10 @@ func (r *instanceResource) Update(ctx context.Context, req resource.UpdateReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", err.Error())
 		return
 	}
-	waitResp, err := wait.UpdateInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	waitResp, err := wait.UpdateInstanceWaitHandler(waitCtx, r.client, projectId, instanceId, region).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", fmt.Sprintf("Instance update waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error updating instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance update waiting: %v", err)))
 		return
 	}
 
@@ -609,9 +698,10 @@ func (r *instanceResource) Delete(ctx context.Context, req resource.DeleteReques
 		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Calling API: %v", err))
 		return
 	}
-	_, err = wait.DeleteInstanceWaitHandler(ctx, r.client, projectId, instanceId, region).WaitWithContext(ctx)
+	waitCtx := core.InitWaitProgress(ctx, "instance")
+	_, err = wait.DeleteInstanceWaitHandler(waitCtx, r.client, projectId, instanceId, region).WaitWithContext(waitCtx)
 	if err != nil {
-		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", fmt.Sprintf("Instance deletion waiting: %v", err))
+		core.LogAndAddError(ctx, &resp.Diagnostics, "Error deleting instance", core.WaitErrorDetail(waitCtx, fmt.Sprintf("Instance deletion waiting: %v", err)))
 		return
 	}
 	tflog.Info(ctx, "SQLServer Flex instance deleted")
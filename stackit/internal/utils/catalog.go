@@ -0,0 +1,280 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
+)
+
+// CatalogCache caches the responses of catalog endpoints (e.g. available machine types or flavors)
+// for the lifetime of the provider process, so that plan-time validations don't repeat API calls
+type CatalogCache[T any] struct {
+	mu      sync.Mutex
+	entries map[string]T
+}
+
+// Get returns the cached entry for the key, calling load to fill the cache if there is none.
+// Failed loads are not cached. The lock is not held while loading, so a slow catalog endpoint doesn't block
+// lookups of other keys; concurrent lookups of the same missing key may load it more than once.
+func (c *CatalogCache[T]) Get(key string, load func() (T, error)) (T, error) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	c.mu.Unlock()
+	if ok {
+		return entry, nil
+	}
+
+	entry, err := load()
+	if err != nil {
+		return entry, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = map[string]T{}
+	}
+	c.entries[key] = entry
+	return entry, nil
+}
+
+// LogCatalogError logs that a catalog couldn't be loaded. Plan-time validations are skipped in that case
+// and the API reports invalid values when applying.
+func LogCatalogError(ctx context.Context, catalog string, err error) {
+	tflog.Warn(ctx, fmt.Sprintf("Skipping plan-time validation, loading %s: %v", catalog, err))
+}
+
+// ValidateCatalogValue adds an error to the diagnostics if value is not one of the available values.
+// The comparison is case-insensitive. The error suggests the closest available value, if any.
+func ValidateCatalogValue(ctx context.Context, diags *diag.Diagnostics, attribute, value string, available []string) bool {
+	for _, v := range available {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	core.LogAndAddError(ctx, diags, fmt.Sprintf("Invalid %s", attribute), CatalogValueErrorDetail(attribute, value, available))
+	return false
+}
+
+// CatalogValueErrorDetail describes that value is not one of the available values of an attribute
+func CatalogValueErrorDetail(attribute, value string, available []string) string {
+	detail := fmt.Sprintf("%q is not a valid %s.", value, attribute)
+	if suggestion := DidYouMean(value, available); suggestion != "" {
+		detail = fmt.Sprintf("%s Did you mean %q?", detail, suggestion)
+	}
+	quoted := make([]string, len(available))
+	for i, v := range available {
+		quoted[i] = fmt.Sprintf("%q", v)
+	}
+	sort.Strings(quoted)
+	return fmt.Sprintf("%s Available values are: %s", detail, strings.Join(quoted, ", "))
+}
+
+// DidYouMean returns the candidate closest to value, or an empty string if no candidate is similar enough
+func DidYouMean(value string, candidates []string) string {
+	value = strings.ToLower(value)
+	best := ""
+	bestDistance := 0
+	for _, candidate := range candidates {
+		distance := levenshteinDistance(value, strings.ToLower(candidate))
+		if best == "" || distance < bestDistance {
+			best = candidate
+			bestDistance = distance
+		}
+	}
+	// Only suggest values that differ in a few characters, e.g. typos
+	maxDistance := len(value) / 3
+	if maxDistance < 2 {
+		maxDistance = 2
+	}
+	if best == "" || bestDistance > maxDistance {
+		return ""
+	}
+	return best
+}
+
+func levenshteinDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// CatalogOffering is a version offered by a service catalog (e.g. LogMe or Redis offerings), with the names of its plans
+type CatalogOffering struct {
+	Version   string
+	PlanNames []string
+}
+
+// ValidateOffering validates the version and the plan name against the offerings. Only changed values are validated,
+// so that existing resources keep planning after their version or plan has been retired.
+// The plan name is also validated if the version changed, as the plans are offered per version.
+func ValidateOffering(ctx context.Context, diags *diag.Diagnostics, offerings []CatalogOffering, version, planName string, versionChanged, planNameChanged bool) {
+	if !versionChanged && !planNameChanged {
+		return
+	}
+
+	var offering *CatalogOffering
+	versions := []string{}
+	for i := range offerings {
+		versions = append(versions, offerings[i].Version)
+		if strings.EqualFold(offerings[i].Version, version) {
+			offering = &offerings[i]
+		}
+	}
+	if offering == nil {
+		// The plans of a retired version are unknown, so the plan name can't be validated
+		if versionChanged {
+			ValidateCatalogValue(ctx, diags, "version", version, versions)
+		}
+		return
+	}
+	ValidateCatalogValue(ctx, diags, "plan_name", planName, offering.PlanNames)
+}
+
+// FlavorSpec is a CPU and RAM combination offered by a flavor catalog
+type FlavorSpec struct {
+	Id  string
+	CPU int64
+	RAM int64
+}
+
+// ValidateFlavorSpec returns the ID of the available flavor with the given CPU and RAM. If there is none,
+// an error suggesting the closest available combination is added to the diagnostics.
+func ValidateFlavorSpec(ctx context.Context, diags *diag.Diagnostics, cpu, ram int64, available []FlavorSpec) (string, bool) {
+	if id, ok := FindFlavorSpec(cpu, ram, available); ok {
+		return id, true
+	}
+
+	var closest *FlavorSpec
+	closestDistance := int64(0)
+	specs := []string{}
+	for i := range available {
+		f := &available[i]
+		specs = append(specs, fmt.Sprintf("%d CPU, %d GB RAM", f.CPU, f.RAM))
+		// Weight CPU differences higher, as a CPU core usually comes with several GB of RAM
+		distance := 4*absInt64(f.CPU-cpu) + absInt64(f.RAM-ram)
+		if closest == nil || distance < closestDistance {
+			closest = f
+			closestDistance = distance
+		}
+	}
+
+	detail := fmt.Sprintf("No flavor with %d CPU and %d GB RAM is available.", cpu, ram)
+	if closest != nil {
+		detail = fmt.Sprintf("%s Did you mean %d CPU and %d GB RAM?", detail, closest.CPU, closest.RAM)
+	}
+	sort.Strings(specs)
+	detail = fmt.Sprintf("%s Available specs are:\n- %s", detail, strings.Join(specs, "\n- "))
+	core.LogAndAddError(ctx, diags, "Invalid flavor", detail)
+	return "", false
+}
+
+// FindFlavorSpec returns the ID of the available flavor with the given CPU and RAM, if there is one
+func FindFlavorSpec(cpu, ram int64, available []FlavorSpec) (string, bool) {
+	for i := range available {
+		if available[i].CPU == cpu && available[i].RAM == ram {
+			return available[i].Id, true
+		}
+	}
+	return "", false
+}
+
+// FlavorStorage is the configured flavor and storage of a Flex instance (e.g. PostgreSQL Flex)
+type FlavorStorage struct {
+	CPU          types.Int64
+	RAM          types.Int64
+	StorageClass types.String
+	StorageSize  types.Int64
+}
+
+// StorageOptions are the storage classes and the storage size range offered for a flavor
+type StorageOptions struct {
+	Classes []string
+	MinSize *int64
+	MaxSize *int64
+}
+
+// ValidateFlavorStorage validates the flavor and storage against the flavors and storage options offered by
+// a Flex service. Only values that differ from the state (nil on create) are validated, so that existing instances
+// keep planning after their flavor or storage class has been retired. The storage options are offered per flavor,
+// so the storage is validated again if the flavor changed. Unknown values are skipped and the catalogs are only
+// loaded if there is something to validate. service is used in the logs if a catalog can't be loaded.
+func ValidateFlavorStorage(ctx context.Context, diags *diag.Diagnostics, service string, config FlavorStorage, state *FlavorStorage, loadFlavors func() ([]FlavorSpec, error), loadStorageOptions func(flavorId string) (*StorageOptions, error)) {
+	if IsUndefined(config.CPU) || IsUndefined(config.RAM) {
+		return
+	}
+	if state == nil {
+		state = &FlavorStorage{}
+	}
+	flavorChanged := !config.CPU.Equal(state.CPU) || !config.RAM.Equal(state.RAM)
+	validateClass := !IsUndefined(config.StorageClass) && (flavorChanged || !config.StorageClass.Equal(state.StorageClass))
+	validateSize := !IsUndefined(config.StorageSize) && (flavorChanged || !config.StorageSize.Equal(state.StorageSize))
+	if !flavorChanged && !validateClass && !validateSize {
+		return
+	}
+
+	flavors, err := loadFlavors()
+	if err != nil {
+		LogCatalogError(ctx, fmt.Sprintf("%s flavors", service), err)
+		return
+	}
+	var flavorId string
+	var ok bool
+	if flavorChanged {
+		flavorId, ok = ValidateFlavorSpec(ctx, diags, config.CPU.ValueInt64(), config.RAM.ValueInt64(), flavors)
+	} else {
+		// the storage options of a retired flavor are unknown
+		flavorId, ok = FindFlavorSpec(config.CPU.ValueInt64(), config.RAM.ValueInt64(), flavors)
+	}
+	if !ok || (!validateClass && !validateSize) {
+		return
+	}
+
+	storageOptions, err := loadStorageOptions(flavorId)
+	if err != nil {
+		LogCatalogError(ctx, fmt.Sprintf("%s storage options", service), err)
+		return
+	}
+	if validateClass {
+		ValidateCatalogValue(ctx, diags, "storage class", config.StorageClass.ValueString(), storageOptions.Classes)
+	}
+	if validateSize && storageOptions.MinSize != nil && storageOptions.MaxSize != nil {
+		ValidateStorageSize(ctx, diags, config.StorageSize.ValueInt64(), *storageOptions.MinSize, *storageOptions.MaxSize)
+	}
+}
+
+// ValidateStorageSize adds an error to the diagnostics if size is outside of the range [minSize, maxSize]
+func ValidateStorageSize(ctx context.Context, diags *diag.Diagnostics, size, minSize, maxSize int64) {
+	if size < minSize || size > maxSize {
+		core.LogAndAddError(ctx, diags, "Invalid storage size", fmt.Sprintf("The storage size %d is not available for the flavor, it must be between %d and %d.", size, minSize, maxSize))
+	}
+}
+
+func absInt64(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
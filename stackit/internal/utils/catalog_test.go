@@ -0,0 +1,451 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkUtils "github.com/stackitcloud/stackit-sdk-go/core/utils"
+)
+
+func TestCatalogCache(t *testing.T) {
+	cache := CatalogCache[[]string]{}
+	calls := 0
+	load := func() ([]string, error) {
+		calls++
+		return []string{"a"}, nil
+	}
+	for i := 0; i < 2; i++ {
+		entry, err := cache.Get("key", load)
+		if err != nil {
+			t.Fatalf("Should not have failed: %v", err)
+		}
+		if len(entry) != 1 || entry[0] != "a" {
+			t.Fatalf("unexpected entry %v", entry)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected 1 load, got %d", calls)
+	}
+
+	_, err := cache.Get("failing", func() ([]string, error) { return nil, fmt.Errorf("error") })
+	if err == nil {
+		t.Fatalf("Should have failed")
+	}
+	entry, err := cache.Get("failing", load)
+	if err != nil || len(entry) != 1 {
+		t.Fatalf("failed loads should not be cached, got %v, %v", entry, err)
+	}
+}
+
+func TestCatalogCacheLoadDoesNotBlock(t *testing.T) {
+	cache := CatalogCache[string]{}
+	loading := make(chan struct{})
+	release := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = cache.Get("slow", func() (string, error) {
+			close(loading)
+			<-release
+			return "slow", nil
+		})
+	}()
+	<-loading
+
+	entry, err := cache.Get("fast", func() (string, error) { return "fast", nil })
+	if err != nil || entry != "fast" {
+		t.Fatalf("unexpected entry %q, %v", entry, err)
+	}
+	close(release)
+	<-done
+}
+
+func TestDidYouMean(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		candidates  []string
+		expected    string
+	}{
+		{
+			"typo",
+			"c1.3",
+			[]string{"c1.2", "g1.1", "m1.4"},
+			"c1.2",
+		},
+		{
+			"case and typo",
+			"Ubuntu",
+			[]string{"flatcar", "ubuntu"},
+			"ubuntu",
+		},
+		{
+			"missing character",
+			"stackit-premium",
+			[]string{"premium-perf2-stackit", "premium-perf12-stackit"},
+			"",
+		},
+		{
+			"plan name typo",
+			"stackit-logme2-1.2.10-replca",
+			[]string{"stackit-logme2-1.2.10-replica", "stackit-logme2-1.2.10-single"},
+			"stackit-logme2-1.2.10-replica",
+		},
+		{
+			"nothing similar",
+			"foo",
+			[]string{"flatcar", "ubuntu"},
+			"",
+		},
+		{
+			"no candidates",
+			"foo",
+			[]string{},
+			"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			suggestion := DidYouMean(tt.value, tt.candidates)
+			if suggestion != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, suggestion)
+			}
+		})
+	}
+}
+
+func TestValidateCatalogValue(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		available   []string
+		isValid     bool
+	}{
+		{
+			"valid",
+			"g1.1",
+			[]string{"c1.1", "g1.1"},
+			true,
+		},
+		{
+			"valid case insensitive",
+			"G1.1",
+			[]string{"c1.1", "g1.1"},
+			true,
+		},
+		{
+			"invalid",
+			"g1.3",
+			[]string{"c1.1", "g1.1"},
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			valid := ValidateCatalogValue(context.Background(), &diags, "machine type", tt.value, tt.available)
+			if valid != tt.isValid {
+				t.Fatalf("expected %t, got %t", tt.isValid, valid)
+			}
+			if diags.HasError() == tt.isValid {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestValidateFlavorSpec(t *testing.T) {
+	available := []FlavorSpec{
+		{Id: "2.4", CPU: 2, RAM: 4},
+		{Id: "4.8", CPU: 4, RAM: 8},
+		{Id: "4.16", CPU: 4, RAM: 16},
+	}
+	tests := []struct {
+		description string
+		cpu         int64
+		ram         int64
+		expectedId  string
+		isValid     bool
+	}{
+		{
+			"valid",
+			4,
+			16,
+			"4.16",
+			true,
+		},
+		{
+			"invalid combination",
+			4,
+			4,
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			id, ok := ValidateFlavorSpec(context.Background(), &diags, tt.cpu, tt.ram, available)
+			if ok != tt.isValid || diags.HasError() == tt.isValid {
+				t.Fatalf("expected valid %t, got %t with diagnostics %v", tt.isValid, ok, diags)
+			}
+			if id != tt.expectedId {
+				t.Fatalf("expected ID %q, got %q", tt.expectedId, id)
+			}
+		})
+	}
+}
+
+func TestValidateOffering(t *testing.T) {
+	offerings := []CatalogOffering{
+		{Version: "1", PlanNames: []string{"plan-single"}},
+		{Version: "2", PlanNames: []string{"plan-single", "plan-replica"}},
+	}
+	tests := []struct {
+		description     string
+		version         string
+		planName        string
+		versionChanged  bool
+		planNameChanged bool
+		isValid         bool
+	}{
+		{
+			"valid",
+			"2",
+			"plan-replica",
+			true,
+			true,
+			true,
+		},
+		{
+			"invalid_version",
+			"3",
+			"plan-single",
+			true,
+			true,
+			false,
+		},
+		{
+			"plan_of_other_version",
+			"1",
+			"plan-replica",
+			true,
+			true,
+			false,
+		},
+		{
+			"version_changed_plan_not_offered",
+			"1",
+			"plan-replica",
+			true,
+			false,
+			false,
+		},
+		{
+			"plan_name_changed_invalid",
+			"2",
+			"plan-foo",
+			false,
+			true,
+			false,
+		},
+		{
+			"unchanged_retired_values",
+			"3",
+			"plan-foo",
+			false,
+			false,
+			true,
+		},
+		{
+			"plan_name_changed_retired_version",
+			"3",
+			"plan-single",
+			false,
+			true,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			diags := diag.Diagnostics{}
+			ValidateOffering(context.Background(), &diags, offerings, tt.version, tt.planName, tt.versionChanged, tt.planNameChanged)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+		})
+	}
+}
+
+func TestValidateFlavorStorage(t *testing.T) {
+	flavors := []FlavorSpec{
+		{Id: "2.4", CPU: 2, RAM: 4},
+		{Id: "4.8", CPU: 4, RAM: 8},
+	}
+	storageOptions := map[string]*StorageOptions{
+		"2.4": {Classes: []string{"premium-perf2", "premium-perf4"}, MinSize: sdkUtils.Ptr(int64(5)), MaxSize: sdkUtils.Ptr(int64(100))},
+		"4.8": {Classes: []string{"premium-perf4"}, MinSize: sdkUtils.Ptr(int64(10)), MaxSize: sdkUtils.Ptr(int64(500))},
+	}
+	flavorStorage := func(cpu, ram int64, class string, size int64) FlavorStorage {
+		return FlavorStorage{
+			CPU:          types.Int64Value(cpu),
+			RAM:          types.Int64Value(ram),
+			StorageClass: types.StringValue(class),
+			StorageSize:  types.Int64Value(size),
+		}
+	}
+	tests := []struct {
+		description          string
+		config               FlavorStorage
+		state                *FlavorStorage
+		expectedFlavorLoad   bool
+		expectedStorageLoads []string
+		isValid              bool
+	}{
+		{
+			"create_valid",
+			flavorStorage(2, 4, "premium-perf2", 10),
+			nil,
+			true,
+			[]string{"2.4"},
+			true,
+		},
+		{
+			"create_invalid_flavor",
+			flavorStorage(3, 4, "premium-perf2", 10),
+			nil,
+			true,
+			nil,
+			false,
+		},
+		{
+			"create_invalid_storage_class",
+			flavorStorage(4, 8, "premium-perf2", 10),
+			nil,
+			true,
+			[]string{"4.8"},
+			false,
+		},
+		{
+			"create_invalid_storage_size",
+			flavorStorage(2, 4, "premium-perf2", 200),
+			nil,
+			true,
+			[]string{"2.4"},
+			false,
+		},
+		{
+			"create_unknown_values",
+			FlavorStorage{
+				CPU:          types.Int64Unknown(),
+				RAM:          types.Int64Value(4),
+				StorageClass: types.StringUnknown(),
+				StorageSize:  types.Int64Unknown(),
+			},
+			nil,
+			false,
+			nil,
+			true,
+		},
+		{
+			"unchanged_retired_flavor_and_storage_class",
+			flavorStorage(8, 32, "premium-perf1", 10),
+			sdkUtils.Ptr(flavorStorage(8, 32, "premium-perf1", 10)),
+			false,
+			nil,
+			true,
+		},
+		{
+			"unchanged_retired_storage_class",
+			flavorStorage(2, 4, "premium-perf1", 20),
+			sdkUtils.Ptr(flavorStorage(2, 4, "premium-perf1", 10)),
+			true,
+			[]string{"2.4"},
+			true,
+		},
+		{
+			"unchanged_retired_flavor_storage_size_changed",
+			flavorStorage(8, 32, "premium-perf1", 20),
+			sdkUtils.Ptr(flavorStorage(8, 32, "premium-perf1", 10)),
+			true,
+			nil,
+			true,
+		},
+		{
+			"changed_storage_size_invalid",
+			flavorStorage(2, 4, "premium-perf2", 200),
+			sdkUtils.Ptr(flavorStorage(2, 4, "premium-perf2", 10)),
+			true,
+			[]string{"2.4"},
+			false,
+		},
+		{
+			"changed_flavor_rechecks_storage",
+			flavorStorage(4, 8, "premium-perf2", 10),
+			sdkUtils.Ptr(flavorStorage(2, 4, "premium-perf2", 10)),
+			true,
+			[]string{"4.8"},
+			false,
+		},
+		{
+			"changed_flavor_to_retired_flavor",
+			flavorStorage(8, 32, "premium-perf4", 10),
+			sdkUtils.Ptr(flavorStorage(4, 8, "premium-perf4", 10)),
+			true,
+			nil,
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			flavorLoaded := false
+			storageLoads := []string{}
+			loadFlavors := func() ([]FlavorSpec, error) {
+				flavorLoaded = true
+				return flavors, nil
+			}
+			loadStorageOptions := func(flavorId string) (*StorageOptions, error) {
+				storageLoads = append(storageLoads, flavorId)
+				return storageOptions[flavorId], nil
+			}
+			diags := diag.Diagnostics{}
+			ValidateFlavorStorage(context.Background(), &diags, "Test Flex", tt.config, tt.state, loadFlavors, loadStorageOptions)
+			if !tt.isValid && !diags.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && diags.HasError() {
+				t.Fatalf("Should not have failed: %v", diags.Errors())
+			}
+			if flavorLoaded != tt.expectedFlavorLoad {
+				t.Fatalf("expected flavors loaded %t, got %t", tt.expectedFlavorLoad, flavorLoaded)
+			}
+			if len(storageLoads) != len(tt.expectedStorageLoads) {
+				t.Fatalf("expected storage option loads %v, got %v", tt.expectedStorageLoads, storageLoads)
+			}
+			for i := range storageLoads {
+				if storageLoads[i] != tt.expectedStorageLoads[i] {
+					t.Fatalf("expected storage option loads %v, got %v", tt.expectedStorageLoads, storageLoads)
+				}
+			}
+		})
+	}
+}
+
+func TestFindFlavorSpec(t *testing.T) {
+	available := []FlavorSpec{
+		{Id: "2.4", CPU: 2, RAM: 4},
+		{Id: "4.8", CPU: 4, RAM: 8},
+	}
+	if id, ok := FindFlavorSpec(4, 8, available); !ok || id != "4.8" {
+		t.Fatalf("expected flavor 4.8, got %q, %t", id, ok)
+	}
+	if id, ok := FindFlavorSpec(4, 4, available); ok {
+		t.Fatalf("expected no flavor, got %q", id)
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ImportNamePrefix marks the part of an import identifier which holds the name of a resource
+// instead of its ID, e.g. "project_id,name=my-instance"
+const ImportNamePrefix = "name="
+
+// ParseImportName returns the name held by an import identifier part of the form "name=<name>".
+// The second return value is false if the part doesn't use this syntax.
+func ParseImportName(idPart string) (string, bool) {
+	name, found := strings.CutPrefix(idPart, ImportNamePrefix)
+	if !found || name == "" {
+		return "", false
+	}
+	return name, true
+}
+
+// ResolveImportName returns the ID of the single resource matching the name given on import.
+// An error is returned if no or multiple resources match.
+func ResolveImportName(kind, name string, matchingIds []string) (string, error) {
+	switch len(matchingIds) {
+	case 0:
+		return "", fmt.Errorf("no %s with name %q found", kind, name)
+	case 1:
+		return matchingIds[0], nil
+	default:
+		return "", fmt.Errorf("found %d resources of type %s with name %q (IDs: %s), import by ID instead", len(matchingIds), kind, name, strings.Join(matchingIds, ", "))
+	}
+}
@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestParseImportName(t *testing.T) {
+	tests := []struct {
+		description  string
+		idPart       string
+		expectedName string
+		expectedOk   bool
+	}{
+		{
+			"name",
+			"name=my-cluster",
+			"my-cluster",
+			true,
+		},
+		{
+			"name with separator characters",
+			"name=example.com.",
+			"example.com.",
+			true,
+		},
+		{
+			"id",
+			"7a3b5ff4-5f71-4eb5-a0ac-d6b2dc5c3f0c",
+			"",
+			false,
+		},
+		{
+			"empty name",
+			"name=",
+			"",
+			false,
+		},
+		{
+			"other key",
+			"id=foo",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			name, ok := ParseImportName(tt.idPart)
+			if name != tt.expectedName || ok != tt.expectedOk {
+				t.Errorf("expected (%q, %t), got (%q, %t)", tt.expectedName, tt.expectedOk, name, ok)
+			}
+		})
+	}
+}
+
+func TestResolveImportName(t *testing.T) {
+	tests := []struct {
+		description string
+		matchingIds []string
+		expectedId  string
+		isValid     bool
+	}{
+		{
+			"single match",
+			[]string{"id-1"},
+			"id-1",
+			true,
+		},
+		{
+			"no match",
+			[]string{},
+			"",
+			false,
+		},
+		{
+			"multiple matches",
+			[]string{"id-1", "id-2"},
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			id, err := ResolveImportName("instance", "my-instance", tt.matchingIds)
+			if !tt.isValid && err == nil {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && err != nil {
+				t.Fatalf("Should not have failed: %v", err)
+			}
+			if id != tt.expectedId {
+				t.Errorf("expected ID %q, got %q", tt.expectedId, id)
+			}
+		})
+	}
+}
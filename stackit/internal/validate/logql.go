@@ -0,0 +1,202 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/prometheus/common/model"
+)
+
+var logQLLabelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*`)
+
+// checkLogQL performs a syntax check of a LogQL expression. It does not implement the full LogQL grammar,
+// but reports the mistakes the Loki API would reject the expression for:
+//   - unterminated string literals and unbalanced parentheses, braces or brackets
+//   - missing or empty stream selectors and malformed label matchers inside them
+//   - regular expressions of label matchers, line filters, label filters and regexp parsers that do not compile
+//   - range durations that are not valid Prometheus durations
+func checkLogQL(expr string) error {
+	if strings.TrimSpace(expr) == "" {
+		return fmt.Errorf("expression is empty")
+	}
+
+	brackets := []byte{}
+	positions := []int{}
+	hasSelector := false
+	// previous holds the last non-whitespace token outside of string literals, to detect regex operands
+	previous := ""
+	for i := 0; i < len(expr); {
+		c := expr[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case c == '"' || c == '`':
+			value, end, err := readLogQLString(expr, i)
+			if err != nil {
+				return err
+			}
+			if previous == "=~" || previous == "!~" || previous == "|~" || previous == "regexp" {
+				if _, err := regexp.Compile(value); err != nil {
+					return fmt.Errorf("invalid regex %q at position %d: %w", value, i+1, err)
+				}
+			}
+			i = end
+			previous = "string"
+			continue
+		case c == '{':
+			end, err := checkLogQLStreamSelector(expr, i)
+			if err != nil {
+				return err
+			}
+			hasSelector = true
+			i = end
+			previous = "}"
+			continue
+		case c == '}':
+			return fmt.Errorf("unexpected '}' at position %d", i+1)
+		case c == '[':
+			end := strings.IndexByte(expr[i:], ']')
+			if end == -1 {
+				return fmt.Errorf("unclosed '[' at position %d", i+1)
+			}
+			duration := strings.TrimSpace(expr[i+1 : i+end])
+			if _, err := model.ParseDuration(duration); err != nil {
+				return fmt.Errorf("invalid range duration %q at position %d: %w", duration, i+1, err)
+			}
+			i += end + 1
+			previous = "]"
+			continue
+		case c == ']':
+			return fmt.Errorf("unexpected ']' at position %d", i+1)
+		case c == '(':
+			brackets = append(brackets, c)
+			positions = append(positions, i)
+			i++
+			previous = "("
+			continue
+		case c == ')':
+			if len(brackets) == 0 {
+				return fmt.Errorf("unexpected ')' at position %d", i+1)
+			}
+			brackets = brackets[:len(brackets)-1]
+			positions = positions[:len(positions)-1]
+			i++
+			previous = ")"
+			continue
+		}
+
+		// Operators and words
+		if strings.HasPrefix(expr[i:], "=~") || strings.HasPrefix(expr[i:], "!~") || strings.HasPrefix(expr[i:], "|~") {
+			previous = expr[i : i+2]
+			i += 2
+			continue
+		}
+		if name := logQLLabelNameRegex.FindString(expr[i:]); name != "" {
+			previous = name
+			i += len(name)
+			continue
+		}
+		previous = string(c)
+		i++
+	}
+
+	if len(brackets) > 0 {
+		return fmt.Errorf("unclosed '(' at position %d", positions[len(positions)-1]+1)
+	}
+	if !hasSelector {
+		return fmt.Errorf("expression must contain a stream selector, e.g. {app=\"foo\"}")
+	}
+	return nil
+}
+
+// checkLogQLStreamSelector checks the stream selector starting with the '{' at position start.
+// It returns the position after the closing '}'.
+func checkLogQLStreamSelector(expr string, start int) (int, error) {
+	i := start + 1
+	matchers := 0
+	for {
+		i = skipLogQLWhitespace(expr, i)
+		if i >= len(expr) {
+			return 0, fmt.Errorf("unclosed '{' at position %d", start+1)
+		}
+		if expr[i] == '}' {
+			if matchers == 0 {
+				return 0, fmt.Errorf("stream selector at position %d must contain at least one label matcher", start+1)
+			}
+			return i + 1, nil
+		}
+		if matchers > 0 {
+			if expr[i] != ',' {
+				return 0, fmt.Errorf("expected ',' or '}' at position %d", i+1)
+			}
+			i = skipLogQLWhitespace(expr, i+1)
+		}
+
+		name := logQLLabelNameRegex.FindString(expr[i:])
+		if name == "" {
+			return 0, fmt.Errorf("expected label name at position %d", i+1)
+		}
+		i = skipLogQLWhitespace(expr, i+len(name))
+
+		operator := ""
+		for _, op := range []string{"=~", "!~", "!=", "="} {
+			if strings.HasPrefix(expr[i:], op) {
+				operator = op
+				break
+			}
+		}
+		if operator == "" {
+			return 0, fmt.Errorf("expected one of '=', '!=', '=~' or '!~' after label %q at position %d", name, i+1)
+		}
+		i = skipLogQLWhitespace(expr, i+len(operator))
+
+		if i >= len(expr) || (expr[i] != '"' && expr[i] != '`') {
+			return 0, fmt.Errorf("expected string value for label %q at position %d", name, i+1)
+		}
+		value, end, err := readLogQLString(expr, i)
+		if err != nil {
+			return 0, err
+		}
+		if operator == "=~" || operator == "!~" {
+			if _, err := regexp.Compile("^(?:" + value + ")$"); err != nil {
+				return 0, fmt.Errorf("invalid regex %q for label %q at position %d: %w", value, name, i+1, err)
+			}
+		}
+		i = end
+		matchers++
+	}
+}
+
+// readLogQLString reads the string literal starting at position start. Double-quoted strings support Go escape sequences,
+// backtick strings are raw. It returns the unquoted value and the position after the closing quote.
+func readLogQLString(expr string, start int) (value string, end int, err error) {
+	quote := expr[start]
+	for i := start + 1; i < len(expr); i++ {
+		switch expr[i] {
+		case '\\':
+			if quote == '"' {
+				i++
+			}
+		case quote:
+			if quote == '`' {
+				return expr[start+1 : i], i + 1, nil
+			}
+			value, err := strconv.Unquote(expr[start : i+1])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid string %s at position %d: %w", expr[start:i+1], start+1, err)
+			}
+			return value, i + 1, nil
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string at position %d", start+1)
+}
+
+func skipLogQLWhitespace(expr string, i int) int {
+	for i < len(expr) && strings.ContainsRune(" \t\n\r", rune(expr[i])) {
+		i++
+	}
+	return i
+}
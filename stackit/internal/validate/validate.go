@@ -15,6 +15,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/prometheus/common/model"
+	"github.com/prometheus/prometheus/promql/parser"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/core"
 	"github.com/stackitcloud/terraform-provider-stackit/stackit/internal/utils"
 	"github.com/teambition/rrule-go"
@@ -23,8 +25,11 @@ import (
 const (
 	MajorMinorVersionRegex = `^\d+\.\d+?$`
 	FullVersionRegex       = `^\d+\.\d+.\d+?$`
+	PrometheusLabelRegex   = `^[a-zA-Z_][a-zA-Z0-9_]*$`
 )
 
+var prometheusLabelRegex = regexp.MustCompile(PrometheusLabelRegex)
+
 type Validator struct {
 	description         string
 	markdownDescription string
@@ -344,3 +349,79 @@ func ValidNoTrailingNewline() *Validator {
 		},
 	}
 }
+
+// PromQL returns a Validator that checks if the input string is a valid PromQL expression.
+func PromQL() *Validator {
+	description := "value must be a valid PromQL expression"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if _, err := parser.ParseExpr(req.ConfigValue.ValueString()); err != nil {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					fmt.Sprintf("%s (%v)", description, err),
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// LogQL returns a Validator that checks if the input string is a syntactically valid LogQL expression.
+// See checkLogQL for the checks that are performed.
+func LogQL() *Validator {
+	description := "value must be a valid LogQL expression"
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if err := checkLogQL(req.ConfigValue.ValueString()); err != nil {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					fmt.Sprintf("%s (%v)", description, err),
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// PrometheusDuration returns a Validator that checks if the input string is a valid Prometheus duration,
+// e.g. "30s", "5m" or "1h30m". Unlike Go durations, Prometheus durations also support "d", "w" and "y"
+// but no fractions or negative values.
+func PrometheusDuration() *Validator {
+	description := "value must be a valid Prometheus duration. Such as \"30s\", \"5m\" or \"1h30m\".\nValid time units are \"ms\", \"s\", \"m\", \"h\", \"d\", \"w\", \"y\"."
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if _, err := model.ParseDuration(req.ConfigValue.ValueString()); err != nil {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
+
+// PrometheusLabelName returns a Validator that checks if the input string is a valid Prometheus label name.
+// Alert rule annotation names have to follow the same rules.
+func PrometheusLabelName() *Validator {
+	description := fmt.Sprintf("value must be a valid label name, matching the regex %q", PrometheusLabelRegex)
+
+	return &Validator{
+		description: description,
+		validate: func(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+			if !prometheusLabelRegex.MatchString(req.ConfigValue.ValueString()) {
+				resp.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+					req.Path,
+					description,
+					req.ConfigValue.ValueString(),
+				))
+			}
+		},
+	}
+}
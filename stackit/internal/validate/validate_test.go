@@ -916,3 +916,313 @@ func TestValidNoTrailingNewline(t *testing.T) {
 		})
 	}
 }
+
+func TestPromQL(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"metric selector",
+			`up{job="node"}`,
+			true,
+		},
+		{
+			"aggregation with comparison",
+			`sum by (instance) (rate(http_requests_total{code=~"5.."}[5m])) > 0.5`,
+			true,
+		},
+		{
+			"function with range vector",
+			`increase(node_cpu_seconds_total[1h]) / on(instance) group_left count(node_cpu_seconds_total)`,
+			true,
+		},
+		{
+			"unclosed parenthesis",
+			`sum(rate(http_requests_total[5m])`,
+			false,
+		},
+		{
+			"unknown function",
+			`foo(up)`,
+			false,
+		},
+		{
+			"invalid range duration",
+			`rate(http_requests_total[5x])`,
+			false,
+		},
+		{
+			"invalid matcher regex",
+			`up{job=~"("}`,
+			false,
+		},
+		{
+			"empty expression",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			va := PromQL()
+			va.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestLogQL(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"log query",
+			`{app="foo"}`,
+			true,
+		},
+		{
+			"log query with pipeline",
+			`{app="foo", env!="dev"} |= "error" != "timeout" | json | level =~ "err|crit" | line_format "{{.msg}}"`,
+			true,
+		},
+		{
+			"metric query",
+			`sum by (app) (count_over_time({app=~"foo|bar"} |~ "(?i)error" [5m])) > 10`,
+			true,
+		},
+		{
+			"metric query with unwrap and regexp parser",
+			"quantile_over_time(0.99, {app=\"foo\"} | regexp `(?P<duration>\\d+)ms` | unwrap duration [1m]) by (pod)",
+			true,
+		},
+		{
+			"escaped quotes",
+			`{app="foo"} |= "say \"hi\""`,
+			true,
+		},
+		{
+			"missing stream selector",
+			`count_over_time(up[5m])`,
+			false,
+		},
+		{
+			"empty stream selector",
+			`count_over_time({}[5m])`,
+			false,
+		},
+		{
+			"matcher without quotes",
+			`{app=foo}`,
+			false,
+		},
+		{
+			"invalid matcher operator",
+			`{app=="foo"}`,
+			false,
+		},
+		{
+			"missing comma between matchers",
+			`{app="foo" env="dev"}`,
+			false,
+		},
+		{
+			"invalid matcher regex",
+			`{app=~"foo("}`,
+			false,
+		},
+		{
+			"invalid line filter regex",
+			`{app="foo"} |~ "[a-"`,
+			false,
+		},
+		{
+			"invalid range duration",
+			`rate({app="foo"}[5 minutes])`,
+			false,
+		},
+		{
+			"unterminated string",
+			`{app="foo}`,
+			false,
+		},
+		{
+			"unclosed parenthesis",
+			`sum(rate({app="foo"}[5m])`,
+			false,
+		},
+		{
+			"unexpected closing parenthesis",
+			`rate({app="foo"}[5m]))`,
+			false,
+		},
+		{
+			"empty expression",
+			"  ",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			va := LogQL()
+			va.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestPrometheusDuration(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"seconds",
+			"30s",
+			true,
+		},
+		{
+			"hours and minutes",
+			"1h30m",
+			true,
+		},
+		{
+			"days",
+			"1d",
+			true,
+		},
+		{
+			"weeks and days",
+			"1w2d",
+			true,
+		},
+		{
+			"zero",
+			"0s",
+			true,
+		},
+		{
+			"fraction",
+			"1.5h",
+			false,
+		},
+		{
+			"negative",
+			"-5m",
+			false,
+		},
+		{
+			"wrong unit order",
+			"30m1h",
+			false,
+		},
+		{
+			"without unit",
+			"30",
+			false,
+		},
+		{
+			"empty string",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			va := PrometheusDuration()
+			va.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
+
+func TestPrometheusLabelName(t *testing.T) {
+	tests := []struct {
+		description string
+		input       string
+		isValid     bool
+	}{
+		{
+			"lowercase",
+			"severity",
+			true,
+		},
+		{
+			"with underscores and digits",
+			"_team_2",
+			true,
+		},
+		{
+			"uppercase",
+			"Summary",
+			true,
+		},
+		{
+			"leading digit",
+			"2team",
+			false,
+		},
+		{
+			"dash",
+			"runbook-url",
+			false,
+		},
+		{
+			"dot",
+			"app.kubernetes.io",
+			false,
+		},
+		{
+			"empty string",
+			"",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.description, func(t *testing.T) {
+			r := validator.StringResponse{}
+			va := PrometheusLabelName()
+			va.ValidateString(context.Background(), validator.StringRequest{
+				ConfigValue: types.StringValue(tt.input),
+			}, &r)
+
+			if !tt.isValid && !r.Diagnostics.HasError() {
+				t.Fatalf("Should have failed")
+			}
+			if tt.isValid && r.Diagnostics.HasError() {
+				t.Fatalf("Should not have failed: %v", r.Diagnostics.Errors())
+			}
+		})
+	}
+}
@@ -58,9 +58,15 @@ import (
 	objecStorageCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credential"
 	objecStorageCredentialsGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/objectstorage/credentialsgroup"
 	alertGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/alertgroup"
+	alertGroupsFromYAML "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/alertgroups-from-yaml"
+	observabilityAlertReceiver "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/alertreceiver"
+	observabilityAlertRoute "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/alertroute"
 	observabilityCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/credential"
+	observabilityGrafanaDashboard "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/grafana-dashboard"
+	observabilityGrafanaFolder "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/grafana-folder"
 	observabilityInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/instance"
 	logAlertGroup "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/log-alertgroup"
+	observabilityPlans "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/plans"
 	observabilityScrapeConfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/observability/scrapeconfig"
 	openSearchCredential "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/opensearch/credential"
 	openSearchInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/opensearch/instance"
@@ -80,7 +86,10 @@ import (
 	serviceAccountKey "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/key"
 	serviceAccountToken "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/serviceaccount/token"
 	skeCluster "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/cluster"
+	skeCredentialsRotation "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/credentialsrotation"
 	skeKubeconfig "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/kubeconfig"
+	skeOperation "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/operation"
+	skeProviderOptions "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/ske/provideroptions"
 	sqlServerFlexInstance "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/instance"
 	sqlServerFlexUser "github.com/stackitcloud/terraform-provider-stackit/stackit/internal/services/sqlserverflex/user"
 )
@@ -145,6 +154,7 @@ type providerModel struct {
 	ResourceManagerCustomEndpoint   types.String `tfsdk:"resourcemanager_custom_endpoint"`
 	TokenCustomEndpoint             types.String `tfsdk:"token_custom_endpoint"`
 	EnableBetaResources             types.Bool   `tfsdk:"enable_beta_resources"`
+	BetaResources                   types.List   `tfsdk:"beta_resources"`
 	ServiceEnablementCustomEndpoint types.String `tfsdk:"service_enablement_custom_endpoint"`
 	Experiments                     types.List   `tfsdk:"experiments"`
 }
@@ -187,6 +197,7 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 		"service_enablement_custom_endpoint": "Custom endpoint for the Service Enablement API",
 		"token_custom_endpoint":              "Custom endpoint for the token API, which is used to request access tokens when using the key flow",
 		"enable_beta_resources":              "Enable beta resources. Default is false.",
+		"beta_resources":                     "List of type names of beta resources and data sources to enable individually, e.g. `stackit_git`. Not needed if `enable_beta_resources` is set to true.",
 		"experiments":                        fmt.Sprintf("Enables experiments. These are unstable features without official support. More information can be found in the README. Available Experiments: %v", strings.Join(features.AvailableExperiments, ", ")),
 	}
 
@@ -340,6 +351,11 @@ func (p *Provider) Schema(_ context.Context, _ provider.SchemaRequest, resp *pro
 				Optional:    true,
 				Description: descriptions["enable_beta_resources"],
 			},
+			"beta_resources": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: descriptions["beta_resources"],
+			},
 			"experiments": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -417,6 +433,15 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 	setStringField(providerConfig.ServiceEnablementCustomEndpoint, func(v string) { providerData.ServiceEnablementCustomEndpoint = v })
 	setBoolField(providerConfig.EnableBetaResources, func(v bool) { providerData.EnableBetaResources = v })
 
+	if !(providerConfig.BetaResources.IsUnknown() || providerConfig.BetaResources.IsNull()) {
+		var betaResourceValues []string
+		diags := providerConfig.BetaResources.ElementsAs(ctx, &betaResourceValues, false)
+		if diags.HasError() {
+			core.LogAndAddError(ctx, &resp.Diagnostics, "Error configuring provider", fmt.Sprintf("Setting up beta resources: %v", diags.Errors()))
+		}
+		providerData.BetaResources = betaResourceValues
+	}
+
 	if !(providerConfig.Experiments.IsUnknown() || providerConfig.Experiments.IsNull()) {
 		var experimentValues []string
 		diags := providerConfig.Experiments.ElementsAs(ctx, &experimentValues, false)
@@ -434,7 +459,7 @@ func (p *Provider) Configure(ctx context.Context, req provider.ConfigureRequest,
 
 	// Make round tripper and custom endpoints available during DataSource and Resource
 	// type Configure methods.
-	providerData.RoundTripper = roundTripper
+	providerData.RoundTripper = core.NewWaitProgressRoundTripper(roundTripper)
 	resp.DataSourceData = providerData
 	resp.ResourceData = providerData
 
@@ -478,7 +503,9 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		objectStorageBucket.NewBucketDataSource,
 		objecStorageCredentialsGroup.NewCredentialsGroupDataSource,
 		objecStorageCredential.NewCredentialDataSource,
+		observabilityCredential.NewCredentialsDataSource,
 		observabilityInstance.NewInstanceDataSource,
+		observabilityPlans.NewPlansDataSource,
 		observabilityScrapeConfig.NewScrapeConfigDataSource,
 		openSearchInstance.NewInstanceDataSource,
 		openSearchCredential.NewCredentialDataSource,
@@ -500,6 +527,9 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		serverUpdateSchedule.NewSchedulesDataSource,
 		serviceAccount.NewServiceAccountDataSource,
 		skeCluster.NewClusterDataSource,
+		skeCluster.NewClustersDataSource,
+		skeProviderOptions.NewKubernetesVersionsDataSource,
+		skeProviderOptions.NewMachineImagesDataSource,
 	}
 }
 
@@ -507,6 +537,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 	resources := []func() resource.Resource{
 		alertGroup.NewAlertGroupResource,
+		alertGroupsFromYAML.NewAlertGroupsFromYAMLResource,
 		cdn.NewDistributionResource,
 		cdnCustomDomain.NewCustomDomainResource,
 		dnsZone.NewZoneResource,
@@ -543,7 +574,11 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		objectStorageBucket.NewBucketResource,
 		objecStorageCredentialsGroup.NewCredentialsGroupResource,
 		objecStorageCredential.NewCredentialResource,
+		observabilityAlertReceiver.NewAlertReceiverResource,
+		observabilityAlertRoute.NewAlertRouteResource,
 		observabilityCredential.NewCredentialResource,
+		observabilityGrafanaDashboard.NewGrafanaDashboardResource,
+		observabilityGrafanaFolder.NewGrafanaFolderResource,
 		observabilityInstance.NewInstanceResource,
 		observabilityScrapeConfig.NewScrapeConfigResource,
 		openSearchInstance.NewInstanceResource,
@@ -566,7 +601,12 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		serviceAccountToken.NewServiceAccountTokenResource,
 		serviceAccountKey.NewServiceAccountKeyResource,
 		skeCluster.NewClusterResource,
+		skeCluster.NewNodePoolResource,
+		skeCredentialsRotation.NewCredentialsRotationResource,
 		skeKubeconfig.NewKubeconfigResource,
+		skeOperation.NewHibernateResource,
+		skeOperation.NewReconcileResource,
+		skeOperation.NewTriggerMaintenanceResource,
 	}
 	resources = append(resources, roleAssignements.NewRoleAssignmentResources()...)
 